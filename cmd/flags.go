@@ -198,6 +198,20 @@ func initBitcoinElectrumFlags(cmd *cobra.Command, cfg *config.Config) {
 		electrum.DefaultKeepAliveInterval,
 		"Interval for connection keep alive requests.",
 	)
+
+	cmd.Flags().DurationVar(
+		&cfg.Bitcoin.Electrum.ReconnectBackoff,
+		"bitcoin.electrum.reconnectBackoff",
+		electrum.DefaultReconnectBackoff,
+		"Initial backoff between Electrum connection re-establishment attempts.",
+	)
+
+	cmd.Flags().DurationVar(
+		&cfg.Bitcoin.Electrum.ReconnectMaxBackoff,
+		"bitcoin.electrum.reconnectMaxBackoff",
+		electrum.DefaultReconnectMaxBackoff,
+		"Maximum backoff between Electrum connection re-establishment attempts.",
+	)
 }
 
 // Initialize flags for Network configuration.
@@ -308,6 +322,42 @@ func initTbtcFlags(cmd *cobra.Command, cfg *config.Config) {
 		tbtc.DefaultKeyGenerationConcurrency,
 		"tECDSA key generation concurrency.",
 	)
+
+	cmd.Flags().Float64Var(
+		&cfg.Tbtc.SigningRateLimit,
+		"tbtc.signingRateLimit",
+		tbtc.DefaultSigningRateLimit,
+		"tECDSA signing requests per second accepted for a single wallet.",
+	)
+
+	cmd.Flags().Uint64Var(
+		&cfg.Tbtc.MaxGasPriceGwei,
+		"tbtc.maxGasPriceGwei",
+		tbtc.DefaultMaxGasPriceGwei,
+		"Maximum gas price in Gwei used for the DKG result submission.",
+	)
+
+	cmd.Flags().UintVar(
+		&cfg.Tbtc.MaxDKGAttempts,
+		"tbtc.maxDkgAttempts",
+		tbtc.DefaultMaxDKGAttempts,
+		"Maximum number of DKG protocol attempts before aborting the DKG. "+
+			"Zero means no limit.",
+	)
+
+	cmd.Flags().DurationVar(
+		&cfg.Tbtc.DKGAnnouncerTimeout,
+		"tbtc.dkgAnnouncerTimeout",
+		0,
+		"Maximum time the readiness announcement of a DKG attempt waits for other group members. Disabled if not set.",
+	)
+
+	cmd.Flags().DurationVar(
+		&cfg.Tbtc.SignerHealthCheckInterval,
+		"tbtc.signerHealthCheckInterval",
+		tbtc.DefaultSignerHealthCheckInterval,
+		"Interval of the persisted signers' key shares integrity checks.",
+	)
 }
 
 // Initialize flags for Maintainer configuration.
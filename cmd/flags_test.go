@@ -122,6 +122,20 @@ var cmdFlagsTests = map[string]struct {
 		expectedValueFromFlag: 660 * time.Second,
 		defaultValue:          300 * time.Second,
 	},
+	"bitcoin.electrum.reconnectBackoff": {
+		readValueFunc:         func(c *config.Config) interface{} { return c.Bitcoin.Electrum.ReconnectBackoff },
+		flagName:              "--bitcoin.electrum.reconnectBackoff",
+		flagValue:             "10s",
+		expectedValueFromFlag: 10 * time.Second,
+		defaultValue:          5 * time.Second,
+	},
+	"bitcoin.electrum.reconnectMaxBackoff": {
+		readValueFunc:         func(c *config.Config) interface{} { return c.Bitcoin.Electrum.ReconnectMaxBackoff },
+		flagName:              "--bitcoin.electrum.reconnectMaxBackoff",
+		flagValue:             "2m",
+		expectedValueFromFlag: 120 * time.Second,
+		defaultValue:          60 * time.Second,
+	},
 	"network.bootstrap": {
 		readValueFunc:         func(c *config.Config) interface{} { return c.LibP2P.Bootstrap },
 		flagName:              "--network.bootstrap",
@@ -225,6 +239,41 @@ var cmdFlagsTests = map[string]struct {
 		expectedValueFromFlag: 101,
 		defaultValue:          runtime.GOMAXPROCS(0),
 	},
+	"tbtc.signingRateLimit": {
+		readValueFunc:         func(c *config.Config) interface{} { return c.Tbtc.SigningRateLimit },
+		flagName:              "--tbtc.signingRateLimit",
+		flagValue:             "2.5",
+		expectedValueFromFlag: 2.5,
+		defaultValue:          1.0,
+	},
+	"tbtc.maxGasPriceGwei": {
+		readValueFunc:         func(c *config.Config) interface{} { return c.Tbtc.MaxGasPriceGwei },
+		flagName:              "--tbtc.maxGasPriceGwei",
+		flagValue:             "250",
+		expectedValueFromFlag: uint64(250),
+		defaultValue:          uint64(500),
+	},
+	"tbtc.maxDkgAttempts": {
+		readValueFunc:         func(c *config.Config) interface{} { return c.Tbtc.MaxDKGAttempts },
+		flagName:              "--tbtc.maxDkgAttempts",
+		flagValue:             "3",
+		expectedValueFromFlag: uint(3),
+		defaultValue:          uint(1),
+	},
+	"tbtc.dkgAnnouncerTimeout": {
+		readValueFunc:         func(c *config.Config) interface{} { return c.Tbtc.DKGAnnouncerTimeout },
+		flagName:              "--tbtc.dkgAnnouncerTimeout",
+		flagValue:             "5m",
+		expectedValueFromFlag: 5 * time.Minute,
+		defaultValue:          time.Duration(0),
+	},
+	"tbtc.signerHealthCheckInterval": {
+		readValueFunc:         func(c *config.Config) interface{} { return c.Tbtc.SignerHealthCheckInterval },
+		flagName:              "--tbtc.signerHealthCheckInterval",
+		flagValue:             "12h",
+		expectedValueFromFlag: 12 * time.Hour,
+		defaultValue:          24 * time.Hour,
+	},
 	"maintainer.bitcoinDifficulty": {
 		readValueFunc:         func(c *config.Config) interface{} { return c.Maintainer.BitcoinDifficulty.Enabled },
 		flagName:              "--bitcoinDifficulty",
@@ -13,6 +13,8 @@ import (
 	"github.com/keep-network/keep-core/pkg/bitcoin"
 	"github.com/keep-network/keep-core/pkg/bitcoin/electrum"
 	"github.com/keep-network/keep-core/pkg/chain/ethereum"
+	"github.com/keep-network/keep-core/pkg/maintainer"
+	"github.com/keep-network/keep-core/pkg/maintainer/btcdiff"
 	"github.com/keep-network/keep-core/pkg/maintainer/spv"
 	"github.com/keep-network/keep-core/pkg/tbtcpg"
 )
@@ -22,16 +24,25 @@ var (
 	walletFlagName = "wallet"
 
 	// listDepositsCommand:
-	hideSweptFlagName = "hide-swept"
-	headFlagName      = "head"
+	hideSweptFlagName        = "hide-swept"
+	headFlagName             = "head"
+	exportCsvFlagName        = "export-csv"
+	outputFileFlagName       = "output-file"
+	minConfirmationsFlagName = "min-confirmations"
+	groupByWalletFlagName    = "group-by-wallet"
 
 	// estimateDepositsSweepFeeCommand:
 	depositsCountFlagName = "deposits-count"
 
 	// submitDepositSweepProofCommand:
 	// submitRedemptionProofCommand:
+	// submitMovingFundsProofCommand:
 	transactionHashFlagName = "transaction-hash"
 	confirmationsFlagName   = "confirmations"
+
+	// retargetEpochCommand:
+	epochFlagName        = "epoch"
+	disableProxyFlagName = "disable-proxy"
 )
 
 // MaintainerCliCommand contains the definition of tools associated with maintainers
@@ -75,6 +86,42 @@ var listDepositsCommand = cobra.Command{
 			return fmt.Errorf("failed to find head flag: %v", err)
 		}
 
+		exportCsv, err := cmd.Flags().GetBool(exportCsvFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to find export csv flag: %v", err)
+		}
+
+		outputFile, err := cmd.Flags().GetString(outputFileFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to find output file flag: %v", err)
+		}
+
+		minConfirmations, err := cmd.Flags().GetUint(minConfirmationsFlagName)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to find min confirmations flag: %v",
+				err,
+			)
+		}
+
+		groupByWallet, err := cmd.Flags().GetBool(groupByWalletFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to find group by wallet flag: %v", err)
+		}
+
+		if exportCsv && groupByWallet {
+			return fmt.Errorf(
+				"grouping deposits by wallet is not supported when " +
+					"exporting deposits to csv",
+			)
+		}
+
+		if exportCsv && len(outputFile) == 0 {
+			return fmt.Errorf(
+				"output file must be set when exporting deposits to csv",
+			)
+		}
+
 		_, tbtcChain, _, _, _, err := ethereum.Connect(
 			ctx,
 			clientConfig.Ethereum,
@@ -110,6 +157,7 @@ var listDepositsCommand = cobra.Command{
 			head,
 			hideSwept,
 			false,
+			minConfirmations,
 		)
 		if err != nil {
 			return fmt.Errorf(
@@ -122,6 +170,27 @@ var listDepositsCommand = cobra.Command{
 			return fmt.Errorf("no deposits found")
 		}
 
+		if exportCsv {
+			if err := exportDepositsCsv(deposits, outputFile); err != nil {
+				return fmt.Errorf("failed to export deposits: %v", err)
+			}
+
+			return nil
+		}
+
+		if groupByWallet {
+			if err := printWalletDepositSummariesTable(
+				tbtcpg.GroupDepositsByWallet(deposits),
+			); err != nil {
+				return fmt.Errorf(
+					"failed to print wallet deposit summaries table: %v",
+					err,
+				)
+			}
+
+			return nil
+		}
+
 		if err := printDepositsTable(deposits); err != nil {
 			return fmt.Errorf("failed to print deposits table: %v", err)
 		}
@@ -158,6 +227,58 @@ func printDepositsTable(deposits []*tbtcpg.Deposit) error {
 	return nil
 }
 
+func printWalletDepositSummariesTable(
+	summaries []*tbtcpg.WalletDepositSummary,
+) error {
+	w := tabwriter.NewWriter(os.Stdout, 2, 4, 1, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(w, "wallet\tunswept value (BTC)\ttotal value (BTC)\tdeposits\tswept\toldest unswept deposit\t\n")
+
+	for _, summary := range summaries {
+		oldestUnsweptDeposit := "-"
+		if deposit := summary.OldestUnsweptDeposit; deposit != nil {
+			oldestUnsweptDeposit = fmt.Sprintf(
+				"%s:%d:%d",
+				deposit.FundingTxHash.Hex(bitcoin.ReversedByteOrder),
+				deposit.FundingOutputIndex,
+				deposit.RevealBlock,
+			)
+		}
+
+		fmt.Fprintf(w, "%s\t%.5f\t%.5f\t%d\t%d\t%s\t\n",
+			hexutils.Encode(summary.WalletPublicKeyHash[:]),
+			summary.UnsweptAmountBtc,
+			summary.TotalAmountBtc,
+			summary.DepositsCount,
+			summary.SweptCount,
+			oldestUnsweptDeposit,
+		)
+	}
+
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("failed to flush the writer: %v", err)
+	}
+
+	return nil
+}
+
+func exportDepositsCsv(deposits []*tbtcpg.Deposit, outputFile string) error {
+	file, err := os.Create(outputFile)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %v", err)
+	}
+
+	if err := tbtcpg.ExportDepositsCSV(deposits, file); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close output file: %v", err)
+	}
+
+	return nil
+}
+
 var estimateDepositsSweepFeeCommand = cobra.Command{
 	Use:              "estimate-deposits-sweep-fee",
 	Short:            "estimates deposits sweep fee",
@@ -166,11 +287,28 @@ var estimateDepositsSweepFeeCommand = cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
 
+		wallet, err := cmd.Flags().GetString(walletFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to find wallet flag: %v", err)
+		}
+
 		depositsCount, err := cmd.Flags().GetInt(depositsCountFlagName)
 		if err != nil {
 			return fmt.Errorf("failed to find deposits count flag: %v", err)
 		}
 
+		var walletPublicKeyHash [20]byte
+		if len(wallet) > 0 {
+			var err error
+			walletPublicKeyHash, err = newWalletPublicKeyHash(wallet)
+			if err != nil {
+				return fmt.Errorf(
+					"failed to extract wallet public key hash: %v",
+					err,
+				)
+			}
+		}
+
 		_, tbtcChain, _, _, _, err := ethereum.Connect(ctx, clientConfig.Ethereum)
 		if err != nil {
 			return fmt.Errorf(
@@ -187,6 +325,7 @@ var estimateDepositsSweepFeeCommand = cobra.Command{
 		fees, err := tbtcpg.EstimateDepositsSweepFee(
 			tbtcChain,
 			btcChain,
+			walletPublicKeyHash,
 			depositsCount,
 		)
 		if err != nil {
@@ -271,7 +410,9 @@ var estimateDepositsSweepFeeCommandDescription = "Estimates the satoshi " +
 	"a Bitcoin sweep transaction containing a specific count of input " +
 	"deposits. All estimations assume the wallet main UTXO is used as one " +
 	"of the transaction's input so the estimation may be overpriced for " +
-	"the very first sweep transaction of each wallet. Estimations also " +
+	"the very first sweep transaction of each wallet. The --wallet flag " +
+	"can be used to take the actual main UTXO of the given wallet into " +
+	"account instead. Estimations also " +
 	"assume only P2WSH deposits are part of the transaction so the " +
 	"estimation may be underpriced if the actual transaction contains " +
 	"legacy P2SH deposits. If the estimated fee exceeds the maximum fee " +
@@ -457,6 +598,171 @@ var submitRedemptionProofCommand = cobra.Command{
 	},
 }
 
+var submitMovingFundsProofCommand = cobra.Command{
+	Use:              "submit-moving-funds-proof",
+	Short:            "submit moving funds proof",
+	Long:             "Submits moving funds proof to the Bridge contract",
+	TraverseChildren: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		_, tbtcChain, _, _, _, err := ethereum.Connect(
+			ctx,
+			clientConfig.Ethereum,
+		)
+		if err != nil {
+			return fmt.Errorf(
+				"could not connect to Ethereum chain: [%v]",
+				err,
+			)
+		}
+
+		btcChain, err := electrum.Connect(ctx, clientConfig.Bitcoin.Electrum)
+		if err != nil {
+			return fmt.Errorf("could not connect to Electrum chain: [%v]", err)
+		}
+
+		transactionHashFlag, err := cmd.Flags().GetString(transactionHashFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to find transaction hash flag: [%v]", err)
+		}
+
+		transactionHash, err := bitcoin.NewHashFromString(
+			transactionHashFlag,
+			bitcoin.ReversedByteOrder,
+		)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to parse transaction hash flag: [%v]",
+				err,
+			)
+		}
+
+		// Allow the caller to request a specific number of confirmations.
+		// The Bridge calculates the required difficulty of a chain of block
+		// headers by multiplying the difficulty of the first block header by
+		// the difficulty factor. If the block headers happen to span the
+		// Bitcoin epoch difficulty change and there is a drop of difficulty
+		// between the epochs, the sum of difficulties from the headers chain
+		// may be too low. Allowing the caller to specify a greater number of
+		// confirmations will ensure the transaction can be proven.
+		requiredConfirmations, err := cmd.Flags().GetUint(confirmationsFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to get confirmations flag: [%v]", err)
+		}
+
+		// If the caller did not provide the number of required confirmations,
+		// use the default value enforced by the chain.
+		if requiredConfirmations == 0 {
+			txProofDifficulty, err := tbtcChain.TxProofDifficultyFactor()
+			if err != nil {
+				return fmt.Errorf(
+					"failed to get transaction proof difficulty factor: [%v]",
+					err,
+				)
+			}
+
+			requiredConfirmations = uint(txProofDifficulty.Int64())
+		}
+
+		logger.Infof(
+			"Submitting moving funds proof for transaction [%s]",
+			transactionHashFlag,
+		)
+
+		if err = spv.SubmitMovingFundsProof(
+			transactionHash,
+			requiredConfirmations,
+			btcChain,
+			tbtcChain,
+		); err != nil {
+			return fmt.Errorf("failed to submit moving funds proof [%v]", err)
+		}
+
+		logger.Infof(
+			"successfully submitted moving funds proof for transaction: [%s]",
+			transactionHashFlag,
+		)
+
+		return nil
+	},
+}
+
+var retargetEpochCommand = cobra.Command{
+	Use:              "retarget-epoch",
+	Short:            "submit Bitcoin difficulty epoch proof",
+	Long:             retargetEpochCommandDescription,
+	TraverseChildren: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		epoch, err := cmd.Flags().GetUint64(epochFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to find epoch flag: [%v]", err)
+		}
+
+		disableProxy, err := cmd.Flags().GetBool(disableProxyFlagName)
+		if err != nil {
+			return fmt.Errorf("failed to find disable proxy flag: [%v]", err)
+		}
+
+		maintainerConfig := maintainer.Config{
+			BitcoinDifficulty: btcdiff.Config{
+				DisableProxy: disableProxy,
+			},
+		}
+
+		btcDiffChain, err := ethereum.ConnectBitcoinDifficulty(
+			ctx,
+			clientConfig.Ethereum,
+			maintainerConfig,
+		)
+		if err != nil {
+			return fmt.Errorf(
+				"could not connect to Bitcoin difficulty chain: [%v]",
+				err,
+			)
+		}
+
+		btcChain, err := electrum.Connect(ctx, clientConfig.Bitcoin.Electrum)
+		if err != nil {
+			return fmt.Errorf("could not connect to Electrum chain: [%v]", err)
+		}
+
+		logger.Infof("Submitting proof of Bitcoin difficulty epoch [%d]", epoch)
+
+		if err := btcdiff.ManualRetarget(
+			ctx,
+			maintainerConfig.BitcoinDifficulty,
+			btcChain,
+			btcDiffChain,
+			epoch,
+		); err != nil {
+			return fmt.Errorf(
+				"failed to submit proof of Bitcoin difficulty epoch: [%v]",
+				err,
+			)
+		}
+
+		logger.Infof(
+			"successfully submitted proof of Bitcoin difficulty epoch [%d]",
+			epoch,
+		)
+
+		return nil
+	},
+}
+
+var retargetEpochCommandDescription = "Submits the proof of the given " +
+	"Bitcoin difficulty epoch to the LightRelay contract. This command is " +
+	"meant to recover the relay manually when the Bitcoin difficulty " +
+	"maintainer fell behind. Epochs must be proven in order so the given " +
+	"epoch must directly follow the epoch currently proven in the relay. " +
+	"By default, the proof is submitted via the LightRelayMaintainerProxy " +
+	"contract so the transaction cost is refunded. The --disable-proxy " +
+	"flag can be used to submit the proof directly to the LightRelay " +
+	"contract instead"
+
 func init() {
 	initFlags(
 		MaintainerCliCommand,
@@ -484,9 +790,40 @@ func init() {
 		"get head of deposits",
 	)
 
+	listDepositsCommand.Flags().Bool(
+		exportCsvFlagName,
+		false,
+		"export deposits to a csv file instead of printing them",
+	)
+
+	listDepositsCommand.Flags().String(
+		outputFileFlagName,
+		"",
+		"path of the csv file deposits are exported to",
+	)
+
+	listDepositsCommand.Flags().Uint(
+		minConfirmationsFlagName,
+		0,
+		"hide deposits whose funding transactions have fewer Bitcoin "+
+			"confirmations (0 disables the filter)",
+	)
+
+	listDepositsCommand.Flags().Bool(
+		groupByWalletFlagName,
+		false,
+		"print one summary row per wallet instead of individual deposits",
+	)
+
 	MaintainerCliCommand.AddCommand(&listDepositsCommand)
 
 	// Estimate Deposits Sweep Fee Subcommand.
+	estimateDepositsSweepFeeCommand.Flags().String(
+		walletFlagName,
+		"",
+		"wallet public key hash",
+	)
+
 	estimateDepositsSweepFeeCommand.Flags().Int(
 		depositsCountFlagName,
 		0,
@@ -548,21 +885,99 @@ func init() {
 	)
 
 	MaintainerCliCommand.AddCommand(&submitRedemptionProofCommand)
+
+	// Submit Moving Funds Proof Subcommand.
+
+	submitMovingFundsProofCommand.Flags().String(
+		transactionHashFlagName,
+		"",
+		"transaction hash the proof will be prepared for (the format should "+
+			"be the same as in Bitcoin explorers).",
+	)
+
+	if err := submitMovingFundsProofCommand.MarkFlagRequired(
+		transactionHashFlagName,
+	); err != nil {
+		logger.Fatalf("failed to mark flag required: [%v]", err)
+	}
+
+	submitMovingFundsProofCommand.Flags().Uint(
+		confirmationsFlagName,
+		0,
+		"(optional) number of confirmations that will be provided in the proof. "+
+			"This is an optional parameter that can be used in a rare event when "+
+			"more confirmations are required to perform a successful proof "+
+			"validation. If this parameter is not provided, the default value, "+
+			"retrieved from the Bridge will be used.",
+	)
+
+	MaintainerCliCommand.AddCommand(&submitMovingFundsProofCommand)
+
+	// Retarget Epoch Subcommand.
+
+	retargetEpochCommand.Flags().Uint64(
+		epochFlagName,
+		0,
+		"number of the Bitcoin difficulty epoch to be proven",
+	)
+
+	if err := retargetEpochCommand.MarkFlagRequired(
+		epochFlagName,
+	); err != nil {
+		logger.Fatalf("failed to mark flag required: [%v]", err)
+	}
+
+	retargetEpochCommand.Flags().Bool(
+		disableProxyFlagName,
+		false,
+		"submit the proof directly to the LightRelay contract without "+
+			"refunding",
+	)
+
+	MaintainerCliCommand.AddCommand(&retargetEpochCommand)
 }
 
+// walletPublicKeyHashUsage describes the expected format of the wallet
+// public key hash passed by operators.
+const walletPublicKeyHashUsage = "expected a 40-character hex string, " +
+	"optionally prefixed with 0x"
+
+// maxDisplayedInputLength is the maximum number of characters of an invalid
+// input that are included in error messages.
+const maxDisplayedInputLength = 40
+
 func newWalletPublicKeyHash(str string) ([20]byte, error) {
 	var result [20]byte
 
 	walletHex, err := hexutils.Decode(str)
 	if err != nil {
-		return result, err
+		return result, fmt.Errorf(
+			"invalid hex string: [%s]; %s",
+			truncateInput(str),
+			walletPublicKeyHashUsage,
+		)
 	}
 
 	if len(walletHex) != 20 {
-		return result, fmt.Errorf("invalid bytes length: [%d], expected: [%d]", len(walletHex), 20)
+		return result, fmt.Errorf(
+			"invalid bytes length: [%d], expected: [%d]; %s",
+			len(walletHex),
+			20,
+			walletPublicKeyHashUsage,
+		)
 	}
 
 	copy(result[:], walletHex)
 
 	return result, nil
 }
+
+// truncateInput shortens the given input to maxDisplayedInputLength
+// characters so that it can be safely included in error messages.
+func truncateInput(input string) string {
+	if len(input) <= maxDisplayedInputLength {
+		return input
+	}
+
+	return input[:maxDisplayedInputLength] + "..."
+}
@@ -14,13 +14,21 @@ var walletPublicKeyHashTests = []struct {
 	wantErr        error // if set, decoding must fail
 }{
 	// invalid
-	{input: ``, wantErr: fmt.Errorf("empty hex string")},
-	{input: `01`, wantErr: fmt.Errorf("invalid bytes length: [1], expected: [20]")},
-	{input: `0x01`, wantErr: fmt.Errorf("invalid bytes length: [1], expected: [20]")},
-	{input: `5bee2805df9fcea4691c442fe4c1a33f7288e2`, wantErr: fmt.Errorf("invalid bytes length: [19], expected: [20]")},
-	{input: `000f4224b6858eee7f8999e6299c056c6405bbede0`, wantErr: fmt.Errorf("invalid bytes length: [21], expected: [20]")},
-	{input: `0x5bee2805df9fcea4691c442fe4c1a33f7288e2`, wantErr: fmt.Errorf("invalid bytes length: [19], expected: [20]")},
-	{input: `0x000f4224b6858eee7f8999e6299c056c6405bbede0`, wantErr: fmt.Errorf("invalid bytes length: [21], expected: [20]")},
+	{input: ``, wantErr: fmt.Errorf("invalid hex string: []; " + walletPublicKeyHashUsage)},
+	{input: `01`, wantErr: fmt.Errorf("invalid bytes length: [1], expected: [20]; " + walletPublicKeyHashUsage)},
+	{input: `0x01`, wantErr: fmt.Errorf("invalid bytes length: [1], expected: [20]; " + walletPublicKeyHashUsage)},
+	{input: `5bee2805df9fcea4691c442fe4c1a33f7288e2`, wantErr: fmt.Errorf("invalid bytes length: [19], expected: [20]; " + walletPublicKeyHashUsage)},
+	{input: `000f4224b6858eee7f8999e6299c056c6405bbede0`, wantErr: fmt.Errorf("invalid bytes length: [21], expected: [20]; " + walletPublicKeyHashUsage)},
+	{input: `0x5bee2805df9fcea4691c442fe4c1a33f7288e2`, wantErr: fmt.Errorf("invalid bytes length: [19], expected: [20]; " + walletPublicKeyHashUsage)},
+	{input: `0x000f4224b6858eee7f8999e6299c056c6405bbede0`, wantErr: fmt.Errorf("invalid bytes length: [21], expected: [20]; " + walletPublicKeyHashUsage)},
+	// invalid hex
+	{input: `wallet`, wantErr: fmt.Errorf("invalid hex string: [wallet]; " + walletPublicKeyHashUsage)},
+	{input: `0x48b88e1`, wantErr: fmt.Errorf("invalid hex string: [0x48b88e1]; " + walletPublicKeyHashUsage)},
+	// correct length but non-hex characters
+	{input: `48b88e1074c33c7a934f781220e1a4523f1768zz`, wantErr: fmt.Errorf("invalid hex string: [48b88e1074c33c7a934f781220e1a4523f1768zz]; " + walletPublicKeyHashUsage)},
+	{input: `0x48b88e1074c33c7a934f781220e1a4523f1768zz`, wantErr: fmt.Errorf("invalid hex string: [0x48b88e1074c33c7a934f781220e1a4523f1768...]; " + walletPublicKeyHashUsage)},
+	// long invalid input is truncated
+	{input: `0xnot-a-wallet-public-key-hash-but-a-very-long-string`, wantErr: fmt.Errorf("invalid hex string: [0xnot-a-wallet-public-key-hash-but-a-ver...]; " + walletPublicKeyHashUsage)},
 	// valid
 	{input: `48b88e1074c33c7a934f781220e1a4523f1768c0`, expectedResult: [20]byte{72, 184, 142, 16, 116, 195, 60, 122, 147, 79, 120, 18, 32, 225, 164, 82, 63, 23, 104, 192}},
 	{input: `0x48b88e1074c33c7a934f781220e1a4523f1768c0`, expectedResult: [20]byte{72, 184, 142, 16, 116, 195, 60, 122, 147, 79, 120, 18, 32, 225, 164, 82, 63, 23, 104, 192}},
@@ -113,6 +113,8 @@ func start(cmd *cobra.Command) error {
 
 		clientInfoRegistry.RegisterBtcChainInfoSource(btcChain)
 
+		clientInfoRegistry.RegisterBtcReadinessCheck(btcChain)
+
 		err = beacon.Initialize(
 			ctx,
 			beaconChain,
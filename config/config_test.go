@@ -161,6 +161,14 @@ func TestReadConfigFromFile(t *testing.T) {
 			readValueFunc: func(c *Config) interface{} { return c.Bitcoin.Electrum.KeepAliveInterval },
 			expectedValue: 720 * time.Second,
 		},
+		"Bitcoin.Electrum.ReconnectBackoff": {
+			readValueFunc: func(c *Config) interface{} { return c.Bitcoin.Electrum.ReconnectBackoff },
+			expectedValue: 7 * time.Second,
+		},
+		"Bitcoin.Electrum.ReconnectMaxBackoff": {
+			readValueFunc: func(c *Config) interface{} { return c.Bitcoin.Electrum.ReconnectMaxBackoff },
+			expectedValue: 90 * time.Second,
+		},
 		"Network.Port": {
 			readValueFunc: func(c *Config) interface{} { return c.LibP2P.Port },
 			expectedValue: 27001,
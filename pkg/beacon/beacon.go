@@ -34,6 +34,10 @@ func Initialize(
 	persistence persistence.ProtectedHandle,
 	scheduler *generator.Scheduler,
 ) error {
+	if err := beaconChain.GetConfig().IsValid(); err != nil {
+		return fmt.Errorf("invalid beacon chain config: [%v]", err)
+	}
+
 	groupRegistry := registry.NewGroupRegistry(logger, beaconChain, persistence)
 	groupRegistry.LoadExistingGroups()
 
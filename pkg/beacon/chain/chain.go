@@ -54,6 +54,13 @@ type GroupSelectionInterface interface {
 	// the given seed. This function can return an error if the beacon chain's
 	// state does not allow for group selection at the moment.
 	SelectGroup(seed *big.Int) (chain.Addresses, error)
+	// SelectGroupWithFallback returns the group members for the group
+	// generated by the given seed. If the sortition pool is unlocked and the
+	// group cannot be selected at the moment, an empty group is returned and
+	// the returned flag is set to true. This is the case when the pool gets
+	// unlocked by a DKG timeout. An error is returned only if the group could
+	// not be selected for any other reason.
+	SelectGroupWithFallback(seed *big.Int) (chain.Addresses, bool, error)
 }
 
 // GroupRegistrationInterface defines the subset of the beacon chain interface
@@ -151,6 +158,68 @@ type Config struct {
 	RelayEntryTimeout uint64
 }
 
+// IsValid checks whether the config values are consistent with each other
+// and usable by the random beacon. It returns a descriptive error for the
+// first violation found or nil if the config is valid.
+func (c *Config) IsValid() error {
+	if c.GroupSize <= 0 {
+		return fmt.Errorf(
+			"group size must be greater than zero; got [%v]",
+			c.GroupSize,
+		)
+	}
+
+	if c.HonestThreshold <= 0 {
+		return fmt.Errorf(
+			"honest threshold must be greater than zero; got [%v]",
+			c.HonestThreshold,
+		)
+	}
+
+	if c.HonestThreshold > c.GroupSize {
+		return fmt.Errorf(
+			"honest threshold [%v] must not be greater than group size [%v]",
+			c.HonestThreshold,
+			c.GroupSize,
+		)
+	}
+
+	if c.ResultPublicationBlockStep == 0 {
+		return fmt.Errorf("result publication block step must be greater than zero")
+	}
+
+	if c.RelayEntryTimeout == 0 {
+		return fmt.Errorf("relay entry timeout must be greater than zero")
+	}
+
+	return nil
+}
+
+// RelayEntryTimeoutBlock returns the block at which a relay request started
+// at the given block times out.
+func (c *Config) RelayEntryTimeoutBlock(requestStartBlock uint64) uint64 {
+	return requestStartBlock + c.RelayEntryTimeout
+}
+
+// CurrentRequestTimeoutBlock returns the block at which the current relay
+// request times out. The value is computed as the current request start block
+// fetched from the chain increased by the relay entry timeout from the given
+// config.
+func CurrentRequestTimeoutBlock(
+	relayEntry RelayEntryInterface,
+	config *Config,
+) (uint64, error) {
+	startBlock, err := relayEntry.CurrentRequestStartBlock()
+	if err != nil {
+		return 0, fmt.Errorf(
+			"failed to get current request start block: [%v]",
+			err,
+		)
+	}
+
+	return config.RelayEntryTimeoutBlock(startBlock.Uint64()), nil
+}
+
 // DishonestThreshold is the maximum number of misbehaving participants for
 // which it is still possible to generate a new relay entry.
 // Misbehaviour is any misconduct to the protocol, including inactivity.
@@ -185,6 +254,36 @@ const hashByteSize = 32
 // DKGResultsVotes is a map of votes for each DKG Result.
 type DKGResultsVotes map[DKGResultHash]int
 
+// MajorityResult returns the hash of the DKG result supported by at least
+// honestThreshold votes. If no result has enough votes, or more than one
+// result has the same highest number of votes, the boolean flag is false.
+func (v DKGResultsVotes) MajorityResult(
+	honestThreshold int,
+) (DKGResultHash, bool) {
+	var (
+		majorityHash  DKGResultHash
+		majorityVotes int
+		tie           bool
+	)
+
+	for hash, votes := range v {
+		switch {
+		case votes > majorityVotes:
+			majorityHash = hash
+			majorityVotes = votes
+			tie = false
+		case votes == majorityVotes:
+			tie = true
+		}
+	}
+
+	if tie || majorityVotes == 0 || majorityVotes < honestThreshold {
+		return DKGResultHash{}, false
+	}
+
+	return majorityHash, true
+}
+
 // Equals checks if two DKG results are equal.
 func (r *DKGResult) Equals(r2 *DKGResult) bool {
 	if r == nil || r2 == nil {
@@ -1,7 +1,12 @@
 package chain
 
 import (
+	"fmt"
+	"math/big"
+	"reflect"
 	"testing"
+
+	"github.com/keep-network/keep-core/internal/testutils"
 )
 
 func TestDKGResultEquals(t *testing.T) {
@@ -65,3 +70,235 @@ func TestDKGResultEquals(t *testing.T) {
 		})
 	}
 }
+
+func TestDKGResultsVotes_MajorityResult(t *testing.T) {
+	hash1 := DKGResultHash{1}
+	hash2 := DKGResultHash{2}
+	hash3 := DKGResultHash{3}
+
+	var tests = map[string]struct {
+		votes           DKGResultsVotes
+		honestThreshold int
+		expectedHash    DKGResultHash
+		expectedOk      bool
+	}{
+		"no votes": {
+			votes:           DKGResultsVotes{},
+			honestThreshold: 3,
+			expectedOk:      false,
+		},
+		"no majority": {
+			votes:           DKGResultsVotes{hash1: 2},
+			honestThreshold: 3,
+			expectedOk:      false,
+		},
+		"exact majority": {
+			votes:           DKGResultsVotes{hash1: 3},
+			honestThreshold: 3,
+			expectedHash:    hash1,
+			expectedOk:      true,
+		},
+		"multiple candidates with one majority": {
+			votes:           DKGResultsVotes{hash1: 1, hash2: 4, hash3: 2},
+			honestThreshold: 3,
+			expectedHash:    hash2,
+			expectedOk:      true,
+		},
+		"multiple candidates without majority": {
+			votes:           DKGResultsVotes{hash1: 2, hash2: 2, hash3: 1},
+			honestThreshold: 3,
+			expectedOk:      false,
+		},
+		"multiple candidates tied above threshold": {
+			votes:           DKGResultsVotes{hash1: 3, hash2: 3},
+			honestThreshold: 3,
+			expectedOk:      false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			hash, ok := test.votes.MajorityResult(test.honestThreshold)
+
+			testutils.AssertBoolsEqual(t, "majority flag", test.expectedOk, ok)
+
+			if hash != test.expectedHash {
+				t.Errorf(
+					"unexpected hash\nexpected: %x\nactual:   %x",
+					test.expectedHash,
+					hash,
+				)
+			}
+		})
+	}
+}
+
+func TestConfig_IsValid(t *testing.T) {
+	validConfig := func() *Config {
+		return &Config{
+			GroupSize:                  64,
+			HonestThreshold:            33,
+			ResultPublicationBlockStep: 1,
+			RelayEntryTimeout:          64,
+		}
+	}
+
+	var tests = map[string]struct {
+		modifyConfig func(config *Config)
+		expectedErr  error
+	}{
+		"valid config": {
+			modifyConfig: func(config *Config) {},
+			expectedErr:  nil,
+		},
+		"honest threshold equal to group size": {
+			modifyConfig: func(config *Config) {
+				config.HonestThreshold = config.GroupSize
+			},
+			expectedErr: nil,
+		},
+		"zero group size": {
+			modifyConfig: func(config *Config) {
+				config.GroupSize = 0
+			},
+			expectedErr: fmt.Errorf(
+				"group size must be greater than zero; got [0]",
+			),
+		},
+		"negative group size": {
+			modifyConfig: func(config *Config) {
+				config.GroupSize = -1
+			},
+			expectedErr: fmt.Errorf(
+				"group size must be greater than zero; got [-1]",
+			),
+		},
+		"zero honest threshold": {
+			modifyConfig: func(config *Config) {
+				config.HonestThreshold = 0
+			},
+			expectedErr: fmt.Errorf(
+				"honest threshold must be greater than zero; got [0]",
+			),
+		},
+		"negative honest threshold": {
+			modifyConfig: func(config *Config) {
+				config.HonestThreshold = -1
+			},
+			expectedErr: fmt.Errorf(
+				"honest threshold must be greater than zero; got [-1]",
+			),
+		},
+		"honest threshold greater than group size": {
+			modifyConfig: func(config *Config) {
+				config.HonestThreshold = config.GroupSize + 1
+			},
+			expectedErr: fmt.Errorf(
+				"honest threshold [65] must not be greater than group size [64]",
+			),
+		},
+		"zero result publication block step": {
+			modifyConfig: func(config *Config) {
+				config.ResultPublicationBlockStep = 0
+			},
+			expectedErr: fmt.Errorf(
+				"result publication block step must be greater than zero",
+			),
+		},
+		"zero relay entry timeout": {
+			modifyConfig: func(config *Config) {
+				config.RelayEntryTimeout = 0
+			},
+			expectedErr: fmt.Errorf(
+				"relay entry timeout must be greater than zero",
+			),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			config := validConfig()
+			test.modifyConfig(config)
+
+			err := config.IsValid()
+
+			if !reflect.DeepEqual(test.expectedErr, err) {
+				t.Errorf(
+					"unexpected error\nexpected: %v\nactual:   %v",
+					test.expectedErr,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func TestConfig_RelayEntryTimeoutBlock(t *testing.T) {
+	config := &Config{RelayEntryTimeout: 64}
+
+	testutils.AssertUintsEqual(
+		t,
+		"relay entry timeout block",
+		1064,
+		config.RelayEntryTimeoutBlock(1000),
+	)
+}
+
+func TestCurrentRequestTimeoutBlock(t *testing.T) {
+	config := &Config{RelayEntryTimeout: 64}
+
+	var tests = map[string]struct {
+		startBlock    *big.Int
+		startBlockErr error
+		expectedBlock uint64
+		expectedErr   error
+	}{
+		"start block fetched": {
+			startBlock:    big.NewInt(1000),
+			expectedBlock: 1000 + config.RelayEntryTimeout,
+		},
+		"start block fetching failed": {
+			startBlockErr: fmt.Errorf("unexpected error"),
+			expectedErr: fmt.Errorf(
+				"failed to get current request start block: [unexpected error]",
+			),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			relayEntry := &mockRelayEntry{
+				currentRequestStartBlock:    test.startBlock,
+				currentRequestStartBlockErr: test.startBlockErr,
+			}
+
+			timeoutBlock, err := CurrentRequestTimeoutBlock(relayEntry, config)
+
+			if !reflect.DeepEqual(test.expectedErr, err) {
+				t.Errorf(
+					"unexpected error\nexpected: %v\nactual:   %v",
+					test.expectedErr,
+					err,
+				)
+			}
+
+			testutils.AssertUintsEqual(
+				t,
+				"timeout block",
+				test.expectedBlock,
+				timeoutBlock,
+			)
+		})
+	}
+}
+
+type mockRelayEntry struct {
+	RelayEntryInterface
+
+	currentRequestStartBlock    *big.Int
+	currentRequestStartBlockErr error
+}
+
+func (mre *mockRelayEntry) CurrentRequestStartBlock() (*big.Int, error) {
+	return mre.currentRequestStartBlock, mre.currentRequestStartBlockErr
+}
@@ -53,7 +53,7 @@ func SignAndSubmit(
 	chainConfig := beaconChain.GetConfig()
 
 	relayEntryTimeoutChannel, err := blockCounter.BlockHeightWaiter(
-		startBlockHeight + chainConfig.RelayEntryTimeout,
+		chainConfig.RelayEntryTimeoutBlock(startBlockHeight),
 	)
 	if err != nil {
 		return err
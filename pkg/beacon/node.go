@@ -67,15 +67,18 @@ func (n *node) JoinDKGIfEligible(
 
 	dkgLogger.Info("checking eligibility for DKG")
 
-	selectedOperators, err := n.beaconChain.SelectGroup(dkgSeed)
+	selectedOperators, fallback, err := n.beaconChain.SelectGroupWithFallback(
+		dkgSeed,
+	)
 	if err != nil {
-		// TODO: We should consider switching this log to Errorf when the
-		// Chaosnet 0 phase is completed and results are submitted to the chain.
-		// To let the operators join the pool, the dev team will keep unlocking
-		// it via DKG timeout from time to time. During the period pool is
-		// unlocked, selecting the group will not work.
-		//dkgLogger.Errorf("failed to select group: [%v]", err)
-		dkgLogger.Warnf("selecting group not possible: [%v]", err)
+		dkgLogger.Errorf("failed to select group: [%v]", err)
+		return
+	}
+	if fallback {
+		// To let the operators join the pool, the pool may get unlocked via
+		// DKG timeout. During the period the pool is unlocked, selecting the
+		// group is not possible.
+		dkgLogger.Warnf("selecting group not possible; sortition pool is unlocked")
 		return
 	}
 
@@ -275,7 +278,7 @@ func (n *node) MonitorRelayEntry(
 	chainConfig := n.beaconChain.GetConfig()
 
 	timeoutWaiterChannel, err := blockCounter.BlockHeightWaiter(
-		relayRequestBlockNumber + chainConfig.RelayEntryTimeout,
+		chainConfig.RelayEntryTimeoutBlock(relayRequestBlockNumber),
 	)
 	if err != nil {
 		logger.Errorf("waiter for a relay entry timeout block failed: [%v]", err)
@@ -18,6 +18,12 @@ const (
 	// DefaultKeepAliveInterval is a default interval used for Electrum server
 	// connection keep alive requests.
 	DefaultKeepAliveInterval = 5 * time.Minute
+	// DefaultReconnectBackoff is a default initial backoff used between
+	// attempts of Electrum connection re-establishment.
+	DefaultReconnectBackoff = 5 * time.Second
+	// DefaultReconnectMaxBackoff is a default maximum backoff used between
+	// attempts of Electrum connection re-establishment.
+	DefaultReconnectMaxBackoff = 60 * time.Second
 )
 
 // Config holds configurable properties.
@@ -36,4 +42,9 @@ type Config struct {
 	// An Electrum server may disconnect clients that have not sent any requests
 	// for roughly 10 minutes.
 	KeepAliveInterval time.Duration
+	// Initial backoff between attempts of connection re-establishment after
+	// the connection was lost. The backoff doubles with every failed attempt.
+	ReconnectBackoff time.Duration
+	// Maximum backoff between attempts of connection re-establishment.
+	ReconnectMaxBackoff time.Duration
 }
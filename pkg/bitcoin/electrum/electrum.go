@@ -6,9 +6,11 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math"
+	"math/rand"
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/checksum0/go-electrum/electrum"
@@ -26,12 +28,23 @@ var (
 	logger                    = log.Logger("keep-electrum")
 )
 
+// pingTimeout is the maximum time the Electrum server has to respond to
+// a ping sent to check the connection health.
+const pingTimeout = 5 * time.Second
+
 // Connection is a handle for interactions with Electrum server.
 type Connection struct {
 	parentCtx   context.Context
 	client      *electrum.Client
 	clientMutex *sync.Mutex
 	config      Config
+
+	// isConnected is set when the connection to the Electrum server is
+	// established and cleared once the connection is lost.
+	isConnected atomic.Bool
+	// clientDone is closed when the current client is replaced, to stop
+	// watching the previous client for connection errors.
+	clientDone chan struct{}
 }
 
 // Connect initializes handle with provided Config.
@@ -51,6 +64,12 @@ func Connect(parentCtx context.Context, config Config) (bitcoin.Chain, error) {
 	if config.KeepAliveInterval == 0 {
 		config.KeepAliveInterval = DefaultKeepAliveInterval
 	}
+	if config.ReconnectBackoff == 0 {
+		config.ReconnectBackoff = DefaultReconnectBackoff
+	}
+	if config.ReconnectMaxBackoff == 0 {
+		config.ReconnectMaxBackoff = DefaultReconnectMaxBackoff
+	}
 
 	c := &Connection{
 		parentCtx:   parentCtx,
@@ -69,11 +88,57 @@ func Connect(parentCtx context.Context, config Config) (bitcoin.Chain, error) {
 	// Keep the connection alive and check the connection health.
 	go c.keepAlive()
 
-	// TODO: Add reconnects on lost connection.
-
 	return c, nil
 }
 
+// IsConnected returns true if the connection to the Electrum server is
+// currently established.
+func (c *Connection) IsConnected() bool {
+	return c.isConnected.Load()
+}
+
+// Ping sends a `server.ping` request to the Electrum server and returns an
+// error if the server does not respond within the ping timeout. Unlike other
+// requests, the ping is not retried so it can be used to check the health
+// of the connection.
+func (c *Connection) Ping() error {
+	return c.ping(pingTimeout)
+}
+
+func (c *Connection) ping(timeout time.Duration) error {
+	c.clientMutex.Lock()
+	client := c.client
+	c.clientMutex.Unlock()
+
+	if client.IsShutdown() {
+		return fmt.Errorf("connection to electrum server is down")
+	}
+
+	ctx, cancelCtx := context.WithTimeout(c.parentCtx, timeout)
+	defer cancelCtx()
+
+	if err := client.Ping(ctx); err != nil {
+		return fmt.Errorf("failed to ping electrum server: [%w]", err)
+	}
+
+	return nil
+}
+
+// Reconnect closes the current connection to the Electrum server and
+// establishes a new one. Failed attempts are retried with a jittered
+// exponential backoff starting at ReconnectBackoff and capped at
+// ReconnectMaxBackoff, until the connection is established or the parent
+// context is done.
+func (c *Connection) Reconnect() error {
+	c.clientMutex.Lock()
+	lostClient := c.client
+	c.isConnected.Store(false)
+	lostClient.Shutdown()
+	c.clientMutex.Unlock()
+
+	return c.reconnect(lostClient)
+}
+
 // GetTransaction gets the transaction with the given transaction hash.
 // If the transaction with the given hash was not found on the chain,
 // this function returns an error.
@@ -1016,12 +1081,106 @@ func (c *Connection) electrumConnect() error {
 	)
 
 	if err == nil {
+		if c.clientDone != nil {
+			close(c.clientDone)
+		}
+
 		c.client = client
+		c.clientDone = make(chan struct{})
+		c.isConnected.Store(true)
+
+		go c.watchConnection(client, c.clientDone)
 	}
 
 	return err
 }
 
+// watchConnection waits for an error reported by the given client, e.g.
+// EOF when the server closes the connection, and re-establishes the
+// connection. Requests that were in flight when the connection was lost
+// are retried by requestWithRetry against the new client.
+func (c *Connection) watchConnection(client *electrum.Client, done <-chan struct{}) {
+	select {
+	case err := <-client.Error:
+		// The client shuts itself down right after reporting the error.
+		c.isConnected.Store(false)
+		logger.Warnf(
+			"connection to electrum server lost: [%v]; reconnecting...",
+			err,
+		)
+
+		if err := c.reconnect(client); err != nil {
+			logger.Errorf("%v", err)
+		}
+	case <-done:
+	case <-c.parentCtx.Done():
+	}
+}
+
+// reconnect re-establishes the connection that was lost by the given client.
+// If the connection has already been re-established in the meantime, e.g.
+// by reconnectIfShutdown, the function returns immediately.
+func (c *Connection) reconnect(lostClient *electrum.Client) error {
+	for attempt := 1; ; attempt++ {
+		c.clientMutex.Lock()
+		if c.client != lostClient && !c.client.IsShutdown() {
+			c.clientMutex.Unlock()
+			return nil
+		}
+		err := c.electrumConnect()
+		c.clientMutex.Unlock()
+
+		if err == nil {
+			logger.Info("reconnected to electrum server")
+			return nil
+		}
+
+		backoff := reconnectBackoff(
+			attempt,
+			c.config.ReconnectBackoff,
+			c.config.ReconnectMaxBackoff,
+		)
+
+		logger.Warnf(
+			"failed to reconnect to electrum server in attempt [%d]; "+
+				"retrying in [%v]: [%v]",
+			attempt,
+			backoff,
+			err,
+		)
+
+		select {
+		case <-time.After(backoff):
+		case <-c.parentCtx.Done():
+			return fmt.Errorf(
+				"failed to reconnect to electrum server: [%w]",
+				c.parentCtx.Err(),
+			)
+		}
+	}
+}
+
+// reconnectBackoff returns the backoff to apply after the given failed
+// reconnect attempt. The backoff doubles with every attempt, is capped at
+// maxBackoff, and is randomized to the range [backoff/2, backoff] so that
+// multiple clients do not reconnect in lockstep.
+func reconnectBackoff(
+	attempt int,
+	initialBackoff time.Duration,
+	maxBackoff time.Duration,
+) time.Duration {
+	backoff := initialBackoff
+	for i := 1; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(backoff-half)+1))
+}
+
 func (c *Connection) verifyServer() error {
 	type Server struct {
 		version  string
@@ -1087,7 +1246,10 @@ func (c *Connection) keepAlive() {
 			}
 		case <-c.parentCtx.Done():
 			ticker.Stop()
+			c.clientMutex.Lock()
+			c.isConnected.Store(false)
 			c.client.Shutdown()
+			c.clientMutex.Unlock()
 			return
 		}
 	}
@@ -1175,6 +1337,7 @@ func (c *Connection) reconnectIfShutdown() error {
 
 	isClientShutdown := c.client.IsShutdown()
 	if isClientShutdown {
+		c.isConnected.Store(false)
 		logger.Warn("connection to electrum server is down; reconnecting...")
 		err := c.electrumConnect()
 		if err != nil {
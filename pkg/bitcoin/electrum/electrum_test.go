@@ -1,7 +1,17 @@
 package electrum
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/checksum0/go-electrum/electrum"
 
 	"github.com/keep-network/keep-core/internal/testutils"
 )
@@ -62,3 +72,331 @@ func TestConvertBtcKbToSatVByte(t *testing.T) {
 		})
 	}
 }
+
+func TestReconnectBackoff(t *testing.T) {
+	initialBackoff := 5 * time.Second
+	maxBackoff := 60 * time.Second
+
+	var tests = map[string]struct {
+		attempt             int
+		expectedBaseBackoff time.Duration
+	}{
+		"first attempt": {
+			attempt:             1,
+			expectedBaseBackoff: 5 * time.Second,
+		},
+		"second attempt": {
+			attempt:             2,
+			expectedBaseBackoff: 10 * time.Second,
+		},
+		"fourth attempt": {
+			attempt:             4,
+			expectedBaseBackoff: 40 * time.Second,
+		},
+		"fifth attempt": {
+			attempt:             5,
+			expectedBaseBackoff: 60 * time.Second,
+		},
+		"hundredth attempt": {
+			attempt:             100,
+			expectedBaseBackoff: 60 * time.Second,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				backoff := reconnectBackoff(
+					test.attempt,
+					initialBackoff,
+					maxBackoff,
+				)
+
+				if backoff < test.expectedBaseBackoff/2 ||
+					backoff > test.expectedBaseBackoff {
+					t.Fatalf(
+						"backoff [%v] is out of the expected range [%v, %v]",
+						backoff,
+						test.expectedBaseBackoff/2,
+						test.expectedBaseBackoff,
+					)
+				}
+			}
+		})
+	}
+}
+
+func TestConnection_ReconnectOnServerDisconnect(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	server := newTestServer(t)
+	defer server.close()
+
+	chain, err := Connect(ctx, Config{
+		URL:                 "tcp://" + server.address(),
+		ConnectTimeout:      time.Second,
+		ConnectRetryTimeout: 5 * time.Second,
+		RequestTimeout:      time.Second,
+		RequestRetryTimeout: 10 * time.Second,
+		ReconnectBackoff:    10 * time.Millisecond,
+		ReconnectMaxBackoff: 100 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	connection := chain.(*Connection)
+
+	testutils.AssertBoolsEqual(
+		t,
+		"connection state",
+		true,
+		connection.IsConnected(),
+	)
+
+	// Drop all connections from the server side; the client should observe
+	// EOF and re-establish the connection on its own.
+	server.disconnectAll()
+
+	waitForCondition(t, func() bool {
+		return server.acceptedConnections() == 2 && connection.IsConnected()
+	})
+
+	// Drop the connection again and issue a request right away. The request
+	// should be retried against the re-established connection.
+	server.disconnectAll()
+
+	_, err = requestWithRetry(
+		connection,
+		func(ctx context.Context, client *electrum.Client) (interface{}, error) {
+			return nil, client.Ping(ctx)
+		},
+		"Ping",
+	)
+	if err != nil {
+		t.Fatalf("unexpected request error: [%v]", err)
+	}
+
+	waitForCondition(t, connection.IsConnected)
+
+	testutils.AssertIntsEqual(
+		t,
+		"accepted connections",
+		3,
+		server.acceptedConnections(),
+	)
+}
+
+func TestConnection_Reconnect(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	server := newTestServer(t)
+	defer server.close()
+
+	chain, err := Connect(ctx, Config{
+		URL:                 "tcp://" + server.address(),
+		ConnectTimeout:      time.Second,
+		ConnectRetryTimeout: 5 * time.Second,
+		RequestTimeout:      time.Second,
+		RequestRetryTimeout: 10 * time.Second,
+		ReconnectBackoff:    10 * time.Millisecond,
+		ReconnectMaxBackoff: 100 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	connection := chain.(*Connection)
+
+	err = connection.Reconnect()
+	if err != nil {
+		t.Fatalf("unexpected reconnect error: [%v]", err)
+	}
+
+	testutils.AssertBoolsEqual(
+		t,
+		"connection state",
+		true,
+		connection.IsConnected(),
+	)
+	waitForCondition(t, func() bool {
+		return server.acceptedConnections() == 2
+	})
+}
+
+func TestConnection_Ping(t *testing.T) {
+	var tests = map[string]struct {
+		silentPing    bool
+		expectedError bool
+	}{
+		"responsive server": {
+			silentPing:    false,
+			expectedError: false,
+		},
+		"silent server": {
+			silentPing:    true,
+			expectedError: true,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancelCtx := context.WithCancel(context.Background())
+			defer cancelCtx()
+
+			server := newTestServer(t)
+			defer server.close()
+
+			chain, err := Connect(ctx, Config{
+				URL:                 "tcp://" + server.address(),
+				ConnectTimeout:      time.Second,
+				ConnectRetryTimeout: 5 * time.Second,
+				RequestTimeout:      time.Second,
+				RequestRetryTimeout: 10 * time.Second,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			connection := chain.(*Connection)
+
+			server.silentPing.Store(test.silentPing)
+
+			timeout := 200 * time.Millisecond
+			startTime := time.Now()
+
+			err = connection.ping(timeout)
+
+			if test.expectedError {
+				if !errors.Is(err, electrum.ErrTimeout) {
+					t.Fatalf("unexpected error: [%v]", err)
+				}
+				if elapsed := time.Since(startTime); elapsed < timeout {
+					t.Fatalf("ping returned before the timeout: [%v]", elapsed)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: [%v]", err)
+			}
+		})
+	}
+}
+
+func waitForCondition(t *testing.T, condition func() bool) {
+	deadline := time.Now().Add(10 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met before the deadline")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// testServer is a minimal Electrum server answering `server.version` and
+// `server.ping` requests over TCP.
+type testServer struct {
+	t        *testing.T
+	listener net.Listener
+
+	mutex       sync.Mutex
+	connections []net.Conn
+	accepted    int
+
+	// silentPing makes the server ignore `server.ping` requests.
+	silentPing atomic.Bool
+}
+
+func newTestServer(t *testing.T) *testServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := &testServer{t: t, listener: listener}
+	go server.serve()
+
+	return server
+}
+
+func (ts *testServer) address() string {
+	return ts.listener.Addr().String()
+}
+
+func (ts *testServer) serve() {
+	for {
+		conn, err := ts.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		ts.mutex.Lock()
+		ts.connections = append(ts.connections, conn)
+		ts.accepted++
+		ts.mutex.Unlock()
+
+		go ts.handle(conn)
+	}
+}
+
+func (ts *testServer) handle(conn net.Conn) {
+	reader := bufio.NewReader(conn)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			return
+		}
+
+		var request struct {
+			ID     uint64 `json:"id"`
+			Method string `json:"method"`
+		}
+		if err := json.Unmarshal(line, &request); err != nil {
+			return
+		}
+
+		if request.Method == "server.ping" && ts.silentPing.Load() {
+			continue
+		}
+
+		var result interface{}
+		if request.Method == "server.version" {
+			result = []string{"TestServer", "1.4"}
+		}
+
+		response, err := json.Marshal(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      request.ID,
+			"result":  result,
+		})
+		if err != nil {
+			return
+		}
+
+		if _, err := conn.Write(append(response, '\n')); err != nil {
+			return
+		}
+	}
+}
+
+func (ts *testServer) disconnectAll() {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	for _, conn := range ts.connections {
+		_ = conn.Close()
+	}
+	ts.connections = nil
+}
+
+func (ts *testServer) acceptedConnections() int {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	return ts.accepted
+}
+
+func (ts *testServer) close() {
+	_ = ts.listener.Close()
+	ts.disconnectAll()
+}
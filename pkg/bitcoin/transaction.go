@@ -23,6 +23,16 @@ const (
 	Witness
 )
 
+const (
+	// CoinbaseMaturity is the number of confirmations a coinbase transaction
+	// must have before its outputs can be spent.
+	CoinbaseMaturity = 100
+
+	// coinbaseOutpointIndex is the output index used by the outpoint of the
+	// coinbase transaction input.
+	coinbaseOutpointIndex = 0xffffffff
+)
+
 // Transaction represents a Bitcoin transaction. For reference, see:
 // https://developer.bitcoin.org/reference/transactions.html#raw-transaction-format
 type Transaction struct {
@@ -183,6 +193,41 @@ func (t *Transaction) WitnessHash() Hash {
 	return ComputeHash(t.Serialize(Witness))
 }
 
+// Weight returns the transaction weight defined by BIP-0141 as the byte size
+// of the Standard serialization format multiplied by 3 plus the byte size
+// of the Witness serialization format. For reference, see:
+// https://github.com/bitcoin/bips/blob/master/bip-0141.mediawiki#transaction-size-calculations
+func (t *Transaction) Weight() int {
+	internal := newInternalTransaction()
+	internal.fromTransaction(t)
+
+	return internal.SerializeSizeStripped()*3 + internal.SerializeSize()
+}
+
+// VirtualSize returns the transaction virtual size defined by BIP-0141 as
+// the transaction weight divided by 4 and rounded up. For transactions
+// without witness inputs, the virtual size is equal to the byte size of the
+// Standard serialization format.
+func (t *Transaction) VirtualSize() int {
+	return (t.Weight() + 3) / 4
+}
+
+// IsCoinbase determines whether the transaction is a coinbase transaction,
+// i.e. the first transaction of a block that creates new coins. A coinbase
+// transaction has exactly one input whose outpoint refers to the zero hash
+// and the maximum output index. For reference, see:
+// https://developer.bitcoin.org/reference/transactions.html#coinbase-input-the-input-of-the-first-transaction-in-a-block
+func (t *Transaction) IsCoinbase() bool {
+	if len(t.Inputs) != 1 || t.Inputs[0].Outpoint == nil {
+		return false
+	}
+
+	outpoint := t.Inputs[0].Outpoint
+
+	return outpoint.TransactionHash == Hash{} &&
+		outpoint.OutputIndex == coinbaseOutpointIndex
+}
+
 // TransactionOutpoint represents a Bitcoin transaction outpoint.
 // For reference, see:
 // https://developer.bitcoin.org/reference/transactions.html#outpoint-the-specific-part-of-a-specific-output
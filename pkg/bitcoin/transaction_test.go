@@ -165,6 +165,124 @@ func TestTransaction_WitnessHash(t *testing.T) {
 	)
 }
 
+func TestTransaction_WeightAndVirtualSize(t *testing.T) {
+	nonWitnessTransaction := transactionFixture(t)
+	for _, input := range nonWitnessTransaction.Inputs {
+		input.Witness = [][]byte{}
+	}
+
+	var tests = map[string]struct {
+		transaction         *Transaction
+		expectedWeight      int
+		expectedVirtualSize int
+	}{
+		"witness transaction": {
+			// The Standard serialization has 365 bytes and the Witness
+			// serialization has 676 bytes.
+			transaction:         transactionFixture(t),
+			expectedWeight:      1771,
+			expectedVirtualSize: 443,
+		},
+		"non-witness transaction": {
+			transaction:         nonWitnessTransaction,
+			expectedWeight:      1460,
+			expectedVirtualSize: 365,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutils.AssertIntsEqual(
+				t,
+				"weight",
+				test.expectedWeight,
+				test.transaction.Weight(),
+			)
+			testutils.AssertIntsEqual(
+				t,
+				"virtual size",
+				test.expectedVirtualSize,
+				test.transaction.VirtualSize(),
+			)
+		})
+	}
+}
+
+func TestTransaction_IsCoinbase(t *testing.T) {
+	coinbaseInput := func() *TransactionInput {
+		return &TransactionInput{
+			Outpoint: &TransactionOutpoint{
+				TransactionHash: Hash{},
+				OutputIndex:     0xffffffff,
+			},
+			SignatureScript: hexToSlice(t, "03a08601"),
+			Sequence:        0xffffffff,
+		}
+	}
+
+	var tests = map[string]struct {
+		transaction func() *Transaction
+		expected    bool
+	}{
+		"regular transaction": {
+			transaction: func() *Transaction {
+				return transactionFixture(t)
+			},
+			expected: false,
+		},
+		"coinbase transaction": {
+			transaction: func() *Transaction {
+				return &Transaction{
+					Version: 1,
+					Inputs:  []*TransactionInput{coinbaseInput()},
+				}
+			},
+			expected: true,
+		},
+		"zero hash outpoint with non-coinbase output index": {
+			transaction: func() *Transaction {
+				input := coinbaseInput()
+				input.Outpoint.OutputIndex = 0
+
+				return &Transaction{
+					Version: 1,
+					Inputs:  []*TransactionInput{input},
+				}
+			},
+			expected: false,
+		},
+		"coinbase-like input among multiple inputs": {
+			transaction: func() *Transaction {
+				transaction := transactionFixture(t)
+				transaction.Inputs = append(
+					[]*TransactionInput{coinbaseInput()},
+					transaction.Inputs...,
+				)
+
+				return transaction
+			},
+			expected: false,
+		},
+		"no inputs": {
+			transaction: func() *Transaction {
+				return &Transaction{Version: 1}
+			},
+			expected: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			testutils.AssertBoolsEqual(
+				t,
+				"is coinbase",
+				test.expected,
+				test.transaction().IsCoinbase(),
+			)
+		})
+	}
+}
+
 // transactionFixture returns a real testnet transaction:
 // https://live.blockcypher.com/btc-testnet/tx/435d4aff6d4bc34134877bd3213c17970142fdd04d4113d534120033b9eecb2e.
 //
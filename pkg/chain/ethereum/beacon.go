@@ -276,6 +276,35 @@ func (bc *BeaconChain) SelectGroup(seed *big.Int) (chain.Addresses, error) {
 	return result, nil
 }
 
+// SelectGroupWithFallback returns the group members for the group generated
+// by the given seed. If the sortition pool is unlocked, the group cannot be
+// selected so an empty group is returned along with the fallback flag set
+// to true.
+func (bc *BeaconChain) SelectGroupWithFallback(seed *big.Int) (
+	chain.Addresses,
+	bool,
+	error,
+) {
+	isPoolLocked, err := bc.IsPoolLocked()
+	if err != nil {
+		return nil, false, fmt.Errorf(
+			"cannot check if the sortition pool is locked: [%v]",
+			err,
+		)
+	}
+
+	if !isPoolLocked {
+		return chain.Addresses{}, true, nil
+	}
+
+	selectedOperators, err := bc.SelectGroup(seed)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return selectedOperators, false, nil
+}
+
 // TODO: Implement a real OnGroupRegistered function.
 func (bc *BeaconChain) OnGroupRegistered(
 	handler func(groupRegistration *event.GroupRegistration),
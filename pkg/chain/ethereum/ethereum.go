@@ -427,6 +427,22 @@ func (bc *baseChain) GetBlockHashByNumber(blockNumber uint64) (
 	return header.Hash(), nil
 }
 
+// IsAvailable returns true if the Ethereum client can fetch the latest block
+// header at the moment. Times out if the underlying client call takes more
+// than 10 seconds.
+func (bc *baseChain) IsAvailable() bool {
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelCtx()
+
+	_, err := bc.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		logger.Warnf("Ethereum client is not available: [%v]", err)
+		return false
+	}
+
+	return true
+}
+
 // currentBlock fetches the current block.
 func (bc *baseChain) currentBlock() (*types.Block, error) {
 	currentBlockNumber, err := bc.blockCounter.CurrentBlock()
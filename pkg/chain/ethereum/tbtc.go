@@ -1,6 +1,7 @@
 package ethereum
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"encoding/binary"
@@ -20,6 +21,7 @@ import (
 
 	"github.com/keep-network/keep-common/pkg/chain/ethereum"
 	"github.com/keep-network/keep-core/pkg/chain"
+	beaconcontract "github.com/keep-network/keep-core/pkg/chain/ethereum/beacon/gen/contract"
 	ecdsaabi "github.com/keep-network/keep-core/pkg/chain/ethereum/ecdsa/gen/abi"
 	ecdsacontract "github.com/keep-network/keep-core/pkg/chain/ethereum/ecdsa/gen/contract"
 	tbtcabi "github.com/keep-network/keep-core/pkg/chain/ethereum/tbtc/gen/abi"
@@ -30,6 +32,7 @@ import (
 	"github.com/keep-network/keep-core/pkg/protocol/inactivity"
 	"github.com/keep-network/keep-core/pkg/subscription"
 	"github.com/keep-network/keep-core/pkg/tbtc"
+	"github.com/keep-network/keep-core/pkg/tecdsa"
 	"github.com/keep-network/keep-core/pkg/tecdsa/dkg"
 )
 
@@ -45,6 +48,12 @@ const (
 
 const (
 	sweptDepositsCachePeriod = 7 * 24 * time.Hour
+
+	// dkgTimedOutLookbackBlocks is the number of blocks preceding a DKG
+	// timeout that are searched for the DKG started event of the timed out
+	// DKG. This is roughly one week of Ethereum blocks which is far beyond
+	// the DKG timeout.
+	dkgTimedOutLookbackBlocks = 50000
 )
 
 // TbtcChain represents a TBTC-specific chain handle.
@@ -57,6 +66,7 @@ type TbtcChain struct {
 	sortitionPool           *ecdsacontract.EcdsaSortitionPool
 	walletProposalValidator *tbtccontract.WalletProposalValidator
 	redemptionWatchtower    *tbtccontract.RedemptionWatchtower
+	randomBeacon            *beaconcontract.RandomBeacon
 
 	sweptDepositsCache *cache.GenericTimeCache[*tbtc.DepositChainRequest]
 }
@@ -238,6 +248,33 @@ func newTbtcChain(
 		}
 	}
 
+	randomBeaconAddress, err := config.ContractAddress(RandomBeaconContractName)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to resolve %s contract address: [%v]",
+			RandomBeaconContractName,
+			err,
+		)
+	}
+
+	randomBeacon, err :=
+		beaconcontract.NewRandomBeacon(
+			randomBeaconAddress,
+			baseChain.chainID,
+			baseChain.key,
+			baseChain.client,
+			baseChain.nonceManager,
+			baseChain.miningWaiter,
+			baseChain.blockCounter,
+			baseChain.transactionMutex,
+		)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to attach to RandomBeacon contract: [%v]",
+			err,
+		)
+	}
+
 	return &TbtcChain{
 		baseChain:               baseChain,
 		bridge:                  bridge,
@@ -246,6 +283,7 @@ func newTbtcChain(
 		sortitionPool:           sortitionPool,
 		walletProposalValidator: walletProposalValidator,
 		redemptionWatchtower:    redemptionWatchtower,
+		randomBeacon:            randomBeacon,
 		sweptDepositsCache:      cache.NewGenericTimeCache[*tbtc.DepositChainRequest](sweptDepositsCachePeriod),
 	}, nil
 }
@@ -520,6 +558,47 @@ func (tc *TbtcChain) PastDKGStartedEvents(
 	return dkgStartedEvents, err
 }
 
+func (tc *TbtcChain) PastRelayEntryRequestedEvents(
+	filter *tbtc.BeaconRelayEntryRequestedEventFilter,
+) ([]*tbtc.BeaconRelayEntryRequestedEvent, error) {
+	var startBlock uint64
+	var endBlock *uint64
+
+	if filter != nil {
+		startBlock = filter.StartBlock
+		endBlock = filter.EndBlock
+	}
+
+	events, err := tc.randomBeacon.PastRelayEntryRequestedEvents(
+		startBlock,
+		endBlock,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	relayEntryRequestedEvents := make(
+		[]*tbtc.BeaconRelayEntryRequestedEvent,
+		len(events),
+	)
+	for i, event := range events {
+		relayEntryRequestedEvents[i] = &tbtc.BeaconRelayEntryRequestedEvent{
+			RequestID:     event.RequestId,
+			GroupID:       event.GroupId,
+			PreviousEntry: event.PreviousEntry,
+			BlockNumber:   event.Raw.BlockNumber,
+		}
+	}
+
+	sort.SliceStable(relayEntryRequestedEvents, func(i, j int) bool {
+		return relayEntryRequestedEvents[i].BlockNumber <
+			relayEntryRequestedEvents[j].BlockNumber
+	})
+
+	return relayEntryRequestedEvents, nil
+}
+
 func (tc *TbtcChain) OnDKGResultSubmitted(
 	handler func(event *tbtc.DKGResultSubmittedEvent),
 ) subscription.EventSubscription {
@@ -661,6 +740,56 @@ func (tc *TbtcChain) OnDKGResultApproved(
 		OnEvent(onEvent)
 }
 
+// OnDKGTimedOut registers a callback that is invoked when an on-chain
+// notification of the DKG timeout is seen. The WalletRegistry's DkgTimedOut
+// event does not carry the DKG seed so, the seed and start block are taken
+// from the latest DKG started event preceding the timeout.
+func (tc *TbtcChain) OnDKGTimedOut(
+	handler func(event *tbtc.DKGTimedOutEvent),
+) subscription.EventSubscription {
+	onEvent := func(blockNumber uint64) {
+		startBlock := uint64(0)
+		if blockNumber > dkgTimedOutLookbackBlocks {
+			startBlock = blockNumber - dkgTimedOutLookbackBlocks
+		}
+
+		dkgStartedEvents, err := tc.PastDKGStartedEvents(
+			&tbtc.DKGStartedEventFilter{
+				StartBlock: startBlock,
+				EndBlock:   &blockNumber,
+			},
+		)
+		if err != nil {
+			logger.Errorf(
+				"cannot get DKG started events preceding DKG timeout "+
+					"at block [%v]: [%v]",
+				blockNumber,
+				err,
+			)
+			return
+		}
+
+		if len(dkgStartedEvents) == 0 {
+			logger.Errorf(
+				"no DKG started events preceding DKG timeout at block [%v]",
+				blockNumber,
+			)
+			return
+		}
+
+		// Events are sorted by block number so, the last one is the latest.
+		dkgStartedEvent := dkgStartedEvents[len(dkgStartedEvents)-1]
+
+		handler(&tbtc.DKGTimedOutEvent{
+			Seed:        dkgStartedEvent.Seed,
+			StartBlock:  dkgStartedEvent.BlockNumber,
+			BlockNumber: blockNumber,
+		})
+	}
+
+	return tc.walletRegistry.DkgTimedOutEvent(nil).OnEvent(onEvent)
+}
+
 // AssembleDKGResult assembles the DKG chain result according to the rules
 // expected by the given chain.
 func (tc *TbtcChain) AssembleDKGResult(
@@ -726,14 +855,32 @@ func (tc *TbtcChain) AssembleDKGResult(
 
 func (tc *TbtcChain) SubmitDKGResult(
 	dkgResult *tbtc.DKGChainResult,
+	gasPrice *big.Int,
 ) error {
+	var transactionOptions []ethutil.TransactionOptions
+	if gasPrice != nil {
+		transactionOptions = append(
+			transactionOptions,
+			ethutil.TransactionOptions{
+				GasFeeCap: gasPrice,
+			},
+		)
+	}
+
 	_, err := tc.walletRegistry.SubmitDkgResult(
 		convertDkgResultToAbiType(dkgResult),
+		transactionOptions...,
 	)
 
 	return err
 }
 
+// SuggestGasPrice returns the gas price suggested by the connected
+// Ethereum client.
+func (tc *TbtcChain) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return tc.client.SuggestGasPrice(ctx)
+}
+
 // computeOperatorsIDsHash computes the keccak256 hash for the given list
 // of operators IDs.
 func computeOperatorsIDsHash(operatorsIDs chain.OperatorIDs) ([32]byte, error) {
@@ -812,6 +959,31 @@ func convertPubKeyToChainFormat(publicKey *ecdsa.PublicKey) ([64]byte, error) {
 	return serialized, nil
 }
 
+// convertPubKeyFromChainFormat takes a 64-byte long public key being
+// a concatenation of X and Y coordinates and converts it to a public key
+// on the tECDSA curve.
+func convertPubKeyFromChainFormat(serialized []byte) (*ecdsa.PublicKey, error) {
+	if len(serialized) != 64 {
+		return nil, fmt.Errorf(
+			"wrong public key length; expected [64] bytes, got [%v]",
+			len(serialized),
+		)
+	}
+
+	x := new(big.Int).SetBytes(serialized[:32])
+	y := new(big.Int).SetBytes(serialized[32:])
+
+	if !tecdsa.Curve.IsOnCurve(x, y) {
+		return nil, fmt.Errorf("public key is not on the curve")
+	}
+
+	return &ecdsa.PublicKey{
+		Curve: tecdsa.Curve,
+		X:     x,
+		Y:     y,
+	}, nil
+}
+
 func (tc *TbtcChain) GetDKGState() (tbtc.DKGState, error) {
 	walletCreationState, err := tc.walletRegistry.GetWalletCreationState()
 	if err != nil {
@@ -839,6 +1011,63 @@ func (tc *TbtcChain) GetDKGState() (tbtc.DKGState, error) {
 	return state, err
 }
 
+func (tc *TbtcChain) GetPendingDKGSession() (*tbtc.DKGSession, error) {
+	dkgState, err := tc.GetDKGState()
+	if err != nil {
+		return nil, fmt.Errorf("cannot get DKG state: [%v]", err)
+	}
+
+	if dkgState != tbtc.AwaitingResult {
+		return nil, nil
+	}
+
+	timedOut, err := tc.walletRegistry.HasDkgTimedOut()
+	if err != nil {
+		return nil, fmt.Errorf("cannot check DKG timeout: [%v]", err)
+	}
+
+	if timedOut {
+		return nil, nil
+	}
+
+	dkgParameters, err := tc.DKGParameters()
+	if err != nil {
+		return nil, fmt.Errorf("cannot get DKG parameters: [%v]", err)
+	}
+
+	currentBlock, err := tc.blockCounter.CurrentBlock()
+	if err != nil {
+		return nil, fmt.Errorf("cannot get current block: [%v]", err)
+	}
+
+	// DKG that has not timed out must have started within the result
+	// submission timeout.
+	startBlock := uint64(0)
+	if currentBlock > dkgParameters.SubmissionTimeoutBlocks {
+		startBlock = currentBlock - dkgParameters.SubmissionTimeoutBlocks
+	}
+
+	events, err := tc.PastDKGStartedEvents(
+		&tbtc.DKGStartedEventFilter{
+			StartBlock: startBlock,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get past DKG started events: [%v]", err)
+	}
+
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	lastEvent := events[len(events)-1]
+
+	return &tbtc.DKGSession{
+		Seed:       lastEvent.Seed,
+		StartBlock: lastEvent.BlockNumber,
+	}, nil
+}
+
 // CalculateDKGResultSignatureHash calculates a 32-byte hash that is used
 // to produce a signature supporting the given groupPublicKey computed
 // as result of the given DKG process. The misbehavedMembersIndexes parameter
@@ -1003,6 +1232,129 @@ func (tc *TbtcChain) DKGParameters() (*tbtc.DKGParameters, error) {
 	}, nil
 }
 
+// PastWalletCreations returns the wallets created between the given blocks,
+// i.e. wallets whose DKG results were approved in that range. The signing
+// group of each wallet is determined from the DKG result the wallet was
+// created from. The end block is optional; if nil, the range ends at the
+// latest block.
+func (tc *TbtcChain) PastWalletCreations(
+	startBlock uint64,
+	endBlock *uint64,
+) ([]*tbtc.WalletCreation, error) {
+	walletCreatedEvents, err := tc.walletRegistry.PastWalletCreatedEvents(
+		startBlock,
+		endBlock,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get past wallet created events: [%v]", err)
+	}
+
+	walletCreations := make([]*tbtc.WalletCreation, 0)
+
+	if len(walletCreatedEvents) == 0 {
+		return walletCreations, nil
+	}
+
+	// A DKG result can be approved only after the challenge period that
+	// starts at the result submission. The submitter has precedence for
+	// the approval so, the result is expected to be submitted no earlier
+	// than that many blocks before the wallet creation.
+	parameters, err := tc.walletRegistry.DkgParameters()
+	if err != nil {
+		return nil, fmt.Errorf("cannot get DKG parameters: [%v]", err)
+	}
+
+	resultLookBackBlocks := parameters.ResultChallengePeriodLength.Uint64() +
+		parameters.SubmitterPrecedencePeriodLength.Uint64()
+
+	resultStartBlock := uint64(0)
+	if startBlock > resultLookBackBlocks {
+		resultStartBlock = startBlock - resultLookBackBlocks
+	}
+
+	resultHashes := make([][32]byte, len(walletCreatedEvents))
+	for i, event := range walletCreatedEvents {
+		resultHashes[i] = event.DkgResultHash
+	}
+
+	dkgResultSubmittedEvents, err := tc.walletRegistry.PastDkgResultSubmittedEvents(
+		resultStartBlock,
+		endBlock,
+		resultHashes,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"cannot get past DKG result submitted events: [%v]",
+			err,
+		)
+	}
+
+	dkgResults := make(map[[32]byte]ecdsaabi.EcdsaDkgResult)
+	for _, event := range dkgResultSubmittedEvents {
+		dkgResults[event.ResultHash] = event.Result
+	}
+
+	for _, event := range walletCreatedEvents {
+		dkgResult, ok := dkgResults[event.DkgResultHash]
+		if !ok {
+			logger.Warnf(
+				"cannot find DKG result [0x%x] of wallet [0x%x] "+
+					"submitted after block [%v]; skipping the wallet",
+				event.DkgResultHash,
+				event.WalletID,
+				resultStartBlock,
+			)
+			continue
+		}
+
+		walletPublicKey, err := convertPubKeyFromChainFormat(
+			dkgResult.GroupPubKey,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"cannot convert public key of wallet [0x%x]: [%v]",
+				event.WalletID,
+				err,
+			)
+		}
+
+		operatorsAddresses, err := tc.sortitionPool.GetIDOperators(
+			dkgResult.Members,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"cannot convert operators' IDs to addresses: [%v]",
+				err,
+			)
+		}
+
+		operators := make(chain.Addresses, len(operatorsAddresses))
+		for i := range operators {
+			operators[i] = chain.Address(operatorsAddresses[i].String())
+		}
+
+		misbehavedMembersIndexes := make(
+			[]group.MemberIndex,
+			len(dkgResult.MisbehavedMembersIndices),
+		)
+		for i, memberIndex := range dkgResult.MisbehavedMembersIndices {
+			misbehavedMembersIndexes[i] = group.MemberIndex(memberIndex)
+		}
+
+		walletCreations = append(walletCreations, &tbtc.WalletCreation{
+			WalletPublicKey:          walletPublicKey,
+			Operators:                operators,
+			MisbehavedMembersIndexes: misbehavedMembersIndexes,
+			BlockNumber:              event.Raw.BlockNumber,
+		})
+	}
+
+	return walletCreations, nil
+}
+
 func (tc *TbtcChain) OnInactivityClaimed(
 	handler func(event *tbtc.InactivityClaimedEvent),
 ) subscription.EventSubscription {
@@ -1184,6 +1536,24 @@ func (tc *TbtcChain) GetInactivityClaimNonce(
 	return nonce, nil
 }
 
+// OnMovingFundsTimedOut registers a callback that is invoked when an on-chain
+// notification of the moving funds timeout is seen.
+func (tc *TbtcChain) OnMovingFundsTimedOut(
+	handler func(event *tbtc.MovingFundsTimedOutEvent),
+) subscription.EventSubscription {
+	onEvent := func(
+		walletPubKeyHash [20]byte,
+		blockNumber uint64,
+	) {
+		handler(&tbtc.MovingFundsTimedOutEvent{
+			WalletPublicKeyHash: walletPubKeyHash,
+			BlockNumber:         blockNumber,
+		})
+	}
+
+	return tc.bridge.MovingFundsTimedOutEvent(nil, nil).OnEvent(onEvent)
+}
+
 func (tc *TbtcChain) PastDepositRevealedEvents(
 	filter *tbtc.DepositRevealedEventFilter,
 ) ([]*tbtc.DepositRevealedEvent, error) {
@@ -2020,6 +2390,82 @@ func (tc *TbtcChain) SubmitMovingFundsCommitment(
 	return err
 }
 
+// OnFraudChallengeSubmitted registers a callback that is invoked when an
+// on-chain notification of the fraud challenge submission is seen.
+func (tc *TbtcChain) OnFraudChallengeSubmitted(
+	handler func(event *tbtc.FraudChallengeSubmittedEvent),
+) subscription.EventSubscription {
+	onEvent := func(
+		walletPubKeyHash [20]byte,
+		sighash [32]byte,
+		v uint8,
+		r [32]byte,
+		s [32]byte,
+		blockNumber uint64,
+	) {
+		handler(&tbtc.FraudChallengeSubmittedEvent{
+			WalletPublicKeyHash: walletPubKeyHash,
+			Sighash:             sighash,
+			BlockNumber:         blockNumber,
+		})
+	}
+
+	return tc.bridge.FraudChallengeSubmittedEvent(nil, nil).OnEvent(onEvent)
+}
+
+// SubmitFraudChallengeDefeatWithHeartbeat defeats the fraud challenge
+// submitted against the given wallet by revealing the challenged heartbeat
+// message to the Bridge contract.
+func (tc *TbtcChain) SubmitFraudChallengeDefeatWithHeartbeat(
+	walletPublicKey *ecdsa.PublicKey,
+	heartbeatMessage []byte,
+) error {
+	walletPublicKeyBytes, err := convertPubKeyToChainFormat(walletPublicKey)
+	if err != nil {
+		return fmt.Errorf(
+			"cannot convert wallet public key to chain format: [%v]",
+			err,
+		)
+	}
+
+	_, err = tc.bridge.DefeatFraudChallengeWithHeartbeat(
+		walletPublicKeyBytes[:],
+		heartbeatMessage,
+	)
+
+	return err
+}
+
+// IsFraudChallengeResolved checks whether the fraud challenge submitted
+// against the given wallet for the given sighash has been already resolved.
+// The challenge is identified by the same key the Bridge contract uses, that
+// is keccak256(walletPublicKey | sighash).
+func (tc *TbtcChain) IsFraudChallengeResolved(
+	walletPublicKey *ecdsa.PublicKey,
+	sighash [32]byte,
+) (bool, error) {
+	walletPublicKeyBytes, err := convertPubKeyToChainFormat(walletPublicKey)
+	if err != nil {
+		return false, fmt.Errorf(
+			"cannot convert wallet public key to chain format: [%v]",
+			err,
+		)
+	}
+
+	challengeKey := crypto.Keccak256Hash(
+		append(walletPublicKeyBytes[:], sighash[:]...),
+	)
+
+	challenge, err := tc.bridge.FraudChallenges(
+		new(big.Int).SetBytes(challengeKey[:]),
+	)
+	if err != nil {
+		return false, fmt.Errorf("cannot get fraud challenge: [%v]", err)
+	}
+
+	return challenge.Resolved, nil
+}
+
 func (tc *TbtcChain) SubmitMovingFundsProofWithReimbursement(
 	transaction *bitcoin.Transaction,
 	proof *bitcoin.SpvProof,
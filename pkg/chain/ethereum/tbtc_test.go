@@ -18,6 +18,7 @@ import (
 	"github.com/keep-network/keep-core/internal/testutils"
 	"github.com/keep-network/keep-core/pkg/chain/local_v1"
 	"github.com/keep-network/keep-core/pkg/protocol/group"
+	"github.com/keep-network/keep-core/pkg/tecdsa"
 )
 
 func TestComputeOperatorsIDsHash(t *testing.T) {
@@ -169,6 +170,49 @@ func TestConvertPubKeyToChainFormat(t *testing.T) {
 	)
 }
 
+func TestConvertPubKeyFromChainFormat(t *testing.T) {
+	publicKey := &ecdsa.PublicKey{
+		Curve: tecdsa.Curve,
+		X:     tecdsa.Curve.Params().Gx,
+		Y:     tecdsa.Curve.Params().Gy,
+	}
+
+	serialized, err := convertPubKeyToChainFormat(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actualPublicKey, err := convertPubKeyFromChainFormat(serialized[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutils.AssertBigIntsEqual(t, "X coordinate", publicKey.X, actualPublicKey.X)
+	testutils.AssertBigIntsEqual(t, "Y coordinate", publicKey.Y, actualPublicKey.Y)
+
+	_, err = convertPubKeyFromChainFormat(serialized[1:])
+	expectedErr := fmt.Errorf("wrong public key length; expected [64] bytes, got [63]")
+	if !reflect.DeepEqual(expectedErr, err) {
+		t.Errorf(
+			"unexpected error\nexpected: [%v]\nactual:   [%v]",
+			expectedErr,
+			err,
+		)
+	}
+
+	notOnCurve := serialized
+	notOnCurve[63]++
+	_, err = convertPubKeyFromChainFormat(notOnCurve[:])
+	expectedErr = fmt.Errorf("public key is not on the curve")
+	if !reflect.DeepEqual(expectedErr, err) {
+		t.Errorf(
+			"unexpected error\nexpected: [%v]\nactual:   [%v]",
+			expectedErr,
+			err,
+		)
+	}
+}
+
 func TestValidateMemberIndex(t *testing.T) {
 	one := big.NewInt(1)
 	maxMemberIndex := big.NewInt(255)
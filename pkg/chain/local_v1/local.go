@@ -134,6 +134,14 @@ func (c *localChain) SelectGroup(seed *big.Int) (chain.Addresses, error) {
 	panic("not implemented")
 }
 
+func (c *localChain) SelectGroupWithFallback(seed *big.Int) (
+	chain.Addresses,
+	bool,
+	error,
+) {
+	panic("not implemented")
+}
+
 func (c *localChain) OnGroupRegistered(
 	handler func(groupRegistration *event.GroupRegistration),
 ) subscription.EventSubscription {
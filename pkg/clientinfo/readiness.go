@@ -0,0 +1,47 @@
+package clientinfo
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/keep-network/keep-core/pkg/bitcoin"
+)
+
+// ReadinessCheckPath is the path of the endpoint reporting whether the client
+// is ready to serve.
+const ReadinessCheckPath = "/readyz"
+
+// RegisterBtcReadinessCheck exposes the readiness check endpoint responding
+// with 503 Service Unavailable if the connection to the Bitcoin chain does
+// not respond to a ping. The endpoint is not exposed if the given Bitcoin
+// chain does not support pinging.
+func (r *Registry) RegisterBtcReadinessCheck(btcChain bitcoin.Chain) {
+	pinger, ok := btcChain.(interface{ Ping() error })
+	if !ok {
+		logger.Warnf(
+			"Bitcoin chain does not support pinging; "+
+				"[%s] endpoint will not be exposed",
+			ReadinessCheckPath,
+		)
+		return
+	}
+
+	http.HandleFunc(ReadinessCheckPath, newReadinessHandler(pinger.Ping))
+}
+
+// newReadinessHandler returns an HTTP handler responding with 200 OK if the
+// given ping function succeeds and with 503 Service Unavailable otherwise.
+func newReadinessHandler(pingFn func() error) http.HandlerFunc {
+	return func(response http.ResponseWriter, _ *http.Request) {
+		if err := pingFn(); err != nil {
+			logger.Warnf("readiness check failed: [%v]", err)
+
+			response.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = fmt.Fprintf(response, "bitcoin chain not ready: %v\n", err)
+			return
+		}
+
+		response.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintln(response, "ok")
+	}
+}
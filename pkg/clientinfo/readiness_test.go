@@ -0,0 +1,47 @@
+package clientinfo
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/keep-network/keep-core/internal/testutils"
+)
+
+func TestReadinessHandler(t *testing.T) {
+	var tests = map[string]struct {
+		pingErr            error
+		expectedStatusCode int
+	}{
+		"responsive server": {
+			pingErr:            nil,
+			expectedStatusCode: http.StatusOK,
+		},
+		"silent server": {
+			pingErr:            fmt.Errorf("timeout"),
+			expectedStatusCode: http.StatusServiceUnavailable,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			handler := newReadinessHandler(func() error {
+				return test.pingErr
+			})
+
+			recorder := httptest.NewRecorder()
+			handler(
+				recorder,
+				httptest.NewRequest(http.MethodGet, ReadinessCheckPath, nil),
+			)
+
+			testutils.AssertIntsEqual(
+				t,
+				"status code",
+				test.expectedStatusCode,
+				recorder.Code,
+			)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package generator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -140,6 +141,31 @@ func (pp *ParameterPool[T]) GetNow() (*T, error) {
 	}
 }
 
+// Drain removes up to n parameters from the pool and returns them without
+// handing them to any consumer. Drained parameters are deleted from the
+// persistence layer, just like the ones returned by GetNow, and the pool
+// starts generating new parameters in their place. Fewer than n parameters
+// are returned if the pool runs out of parameters. If a parameter could not
+// be deleted from the persistence layer, the parameters drained so far are
+// returned along with the error.
+func (pp *ParameterPool[T]) Drain(n int) ([]*T, error) {
+	drained := make([]*T, 0)
+
+	for len(drained) < n {
+		parameter, err := pp.GetNow()
+		if errors.Is(err, ErrEmptyPool) {
+			break
+		}
+		if err != nil {
+			return drained, err
+		}
+
+		drained = append(drained, parameter)
+	}
+
+	return drained, nil
+}
+
 // ParametersCount returns the number of parameters in the pool.
 func (pp *ParameterPool[T]) ParametersCount() int {
 	return len(pp.pool)
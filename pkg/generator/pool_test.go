@@ -213,6 +213,75 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+// TestDrain ensures parameters are drained from the pool up to the requested
+// count or the pool size, whichever is lower, and are deleted from the
+// persistence layer.
+func TestDrain(t *testing.T) {
+	persistence := &mockPersistence{storage: make(map[string]*big.Int)}
+	for _, value := range []int64{100, 200, 300} {
+		if _, err := persistence.Save(big.NewInt(value)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pool, scheduler := newTestPoolWithPersistence(
+		100,
+		persistence,
+		func(ctx context.Context) *big.Int {
+			<-ctx.Done()
+			return nil
+		},
+	)
+	defer scheduler.stop()
+
+	var tests = []struct {
+		n                     int
+		expectedDrainedCount  int
+		expectedPoolSizeAfter int
+	}{
+		{n: 0, expectedDrainedCount: 0, expectedPoolSizeAfter: 3},
+		{n: 2, expectedDrainedCount: 2, expectedPoolSizeAfter: 1},
+		{n: 5, expectedDrainedCount: 1, expectedPoolSizeAfter: 0},
+		{n: 1, expectedDrainedCount: 0, expectedPoolSizeAfter: 0},
+	}
+
+	for _, test := range tests {
+		drained, err := pool.Drain(test.n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testutils.AssertIntsEqual(
+			t,
+			fmt.Sprintf("drained parameters count for n=%d", test.n),
+			test.expectedDrainedCount,
+			len(drained),
+		)
+		testutils.AssertIntsEqual(
+			t,
+			fmt.Sprintf("pool size after draining for n=%d", test.n),
+			test.expectedPoolSizeAfter,
+			pool.ParametersCount(),
+		)
+
+		for _, parameter := range drained {
+			if persistence.isPresent(parameter) {
+				t.Errorf(
+					"element should be deleted from persistence: [%v]",
+					parameter,
+				)
+			}
+		}
+	}
+
+	testutils.AssertIntsEqual(
+		t,
+		"persisted parameters count",
+		0,
+		persistence.parameterCount(),
+	)
+}
+
 func newTestPool(
 	targetSize int,
 	optionalGenerateFn ...func(context.Context) *big.Int,
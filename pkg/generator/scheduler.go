@@ -52,6 +52,22 @@ func (s *Scheduler) RegisterProtocol(protocol Protocol) {
 	s.protocols = append(s.protocols, protocol)
 }
 
+// ActiveProtocols returns the number of registered protocols that are
+// currently executing.
+func (s *Scheduler) ActiveProtocols() int {
+	s.protocolsMutex.Lock()
+	defer s.protocolsMutex.Unlock()
+
+	activeProtocols := 0
+	for _, protocol := range s.protocols {
+		if protocol.IsExecuting() {
+			activeProtocols++
+		}
+	}
+
+	return activeProtocols
+}
+
 // Compute takes the worker function and starts the computations in a separate
 // goroutine if the scheduler status is "working". Otherwise, when the scheduler
 // status is "stopped", the worker function is scheduled for execution later.
@@ -3,6 +3,7 @@ package generator
 import (
 	"context"
 	"math/big"
+	"sync"
 	"testing"
 	"time"
 
@@ -420,6 +421,73 @@ func TestCheckProtocols_ProtocolFinishedExecution(t *testing.T) {
 	)
 }
 
+func TestActiveProtocols(t *testing.T) {
+	scheduler := new(Scheduler)
+
+	latchesCount := 10
+
+	latches := make([]*ProtocolLatch, latchesCount)
+	for i := range latches {
+		latches[i] = NewProtocolLatch()
+		scheduler.RegisterProtocol(latches[i])
+	}
+
+	testutils.AssertIntsEqual(
+		t,
+		"active protocols before lock",
+		0,
+		scheduler.ActiveProtocols(),
+	)
+
+	var wg sync.WaitGroup
+	wg.Add(latchesCount)
+	for _, latch := range latches {
+		go func(latch *ProtocolLatch) {
+			defer wg.Done()
+			latch.Lock()
+		}(latch)
+	}
+	wg.Wait()
+
+	testutils.AssertIntsEqual(
+		t,
+		"active protocols after lock",
+		latchesCount,
+		scheduler.ActiveProtocols(),
+	)
+
+	// Locking the same latch again must not increase the number of active
+	// protocols.
+	latches[0].Lock()
+
+	testutils.AssertIntsEqual(
+		t,
+		"active protocols after second lock of the same latch",
+		latchesCount,
+		scheduler.ActiveProtocols(),
+	)
+
+	latches[0].Unlock()
+
+	unlockedCount := latchesCount / 2
+
+	wg.Add(unlockedCount)
+	for _, latch := range latches[:unlockedCount] {
+		go func(latch *ProtocolLatch) {
+			defer wg.Done()
+			latch.Unlock()
+		}(latch)
+	}
+	wg.Wait()
+
+	testutils.AssertIntsEqual(
+		t,
+		"active protocols after unlock",
+		latchesCount-unlockedCount,
+		scheduler.ActiveProtocols(),
+	)
+}
+
 type mockProtocol struct {
 	isExecuting bool
 }
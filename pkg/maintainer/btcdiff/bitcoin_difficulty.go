@@ -61,6 +61,27 @@ func Initialize(
 	go bitcoinDifficultyMaintainer.startControlLoop(ctx)
 }
 
+// ManualRetarget proves the given Bitcoin blockchain epoch in the Bitcoin
+// difficulty chain. It is meant to be used by operators to recover the
+// Bitcoin difficulty chain when the automatic maintainer fell behind. The
+// given epoch must directly follow the epoch currently proven in the Bitcoin
+// difficulty chain.
+func ManualRetarget(
+	ctx context.Context,
+	config Config,
+	btcChain bitcoin.Chain,
+	chain Chain,
+	epochNumber uint64,
+) error {
+	bitcoinDifficultyMaintainer := &bitcoinDifficultyMaintainer{
+		config:   config,
+		btcChain: btcChain,
+		chain:    chain,
+	}
+
+	return bitcoinDifficultyMaintainer.manualRetarget(ctx, epochNumber)
+}
+
 // bitcoinDifficultyMaintainer is the part of maintainer responsible for
 // maintaining the state of the Bitcoin difficulty on-chain contract.
 type bitcoinDifficultyMaintainer struct {
@@ -223,74 +244,25 @@ func (bdm *bitcoinDifficultyMaintainer) proveNextEpoch(ctx context.Context) (
 	// Height of the first block of the new epoch.
 	newEpochHeight := newEpoch * bitcoinDifficultyEpochLength
 
-	// The range of block headers to be pulled from the Bitcoin chain should
-	// start `proofLength` blocks before the first block of the new difficulty
-	// epoch and end `proofLength`-1 after it.
-	// For example, if the new epoch begins at block 522144 and `proofLength`
-	// is 3, then the range should be [522141, 522146]:
-	// 522141 <- old difficulty target
-	// 522142 <- old difficulty target
-	// 522143 <- old difficulty target
-	// << difficulty retarget >>
-	// 522144 <- new difficulty target (first block of the new epoch)
-	// 522145 <- new difficulty target
-	// 522146 <- new difficulty target
-	firstBlockHeaderHeight := newEpochHeight - uint(proofLength)
-	lastBlockHeaderHeight := newEpochHeight + uint(proofLength) - 1
+	firstBlockHeaderHeight, lastBlockHeaderHeight := retargetHeadersRange(
+		newEpoch,
+		uint(proofLength),
+	)
 
 	// The required range of block headers can be pulled from the Bitcoin
 	// blockchain only if the blockchain height is equal to or greater than
 	// the end of the range.
 	if currentBlockHeight >= lastBlockHeaderHeight {
-		headers, err := bdm.getBlockHeaders(
+		err := bdm.proveEpoch(
+			ctx,
+			newEpoch,
 			firstBlockHeaderHeight,
 			lastBlockHeaderHeight,
 		)
 		if err != nil {
-			return false, fmt.Errorf(
-				"failed to get block headers from Bitcoin chain: [%w]",
-				err,
-			)
+			return false, err
 		}
 
-		if bdm.config.DisableProxy {
-			if err := bdm.chain.Retarget(headers); err != nil {
-				return false, fmt.Errorf(
-					"failed to submit block headers from range [%d:%d] via "+
-						"Retarget: [%w]",
-					firstBlockHeaderHeight,
-					lastBlockHeaderHeight,
-					err,
-				)
-			}
-		} else {
-			if err := bdm.chain.RetargetWithRefund(headers); err != nil {
-				return false, fmt.Errorf(
-					"failed to submit block headers from range [%d:%d] via "+
-						"RetargetWithRefund: [%w]",
-					firstBlockHeaderHeight,
-					lastBlockHeaderHeight,
-					err,
-				)
-			}
-		}
-
-		if err := bdm.waitForCurrentEpochUpdate(ctx, uint64(newEpoch)); err != nil {
-			return false, fmt.Errorf(
-				"error while waiting for current Bitcoin difficulty epoch "+
-					"update: [%w]",
-				err,
-			)
-		}
-
-		logger.Infof(
-			"successfully submitted block headers [%d:%d] to the Bitcoin "+
-				"difficulty chain; the current proven epoch is [%d]",
-			firstBlockHeaderHeight,
-			lastBlockHeaderHeight,
-			newEpoch,
-		)
-
 		return true, nil
 	}
 
@@ -311,6 +283,158 @@ func (bdm *bitcoinDifficultyMaintainer) proveNextEpoch(ctx context.Context) (
 	return false, nil
 }
 
+// retargetHeadersRange returns the heights of the first and the last block
+// headers forming the retarget proof of the given epoch.
+//
+// The range of block headers to be pulled from the Bitcoin chain should
+// start `proofLength` blocks before the first block of the new difficulty
+// epoch and end `proofLength`-1 after it.
+// For example, if the new epoch begins at block 522144 and `proofLength`
+// is 3, then the range should be [522141, 522146]:
+// 522141 <- old difficulty target
+// 522142 <- old difficulty target
+// 522143 <- old difficulty target
+// << difficulty retarget >>
+// 522144 <- new difficulty target (first block of the new epoch)
+// 522145 <- new difficulty target
+// 522146 <- new difficulty target
+func retargetHeadersRange(epoch uint, proofLength uint) (uint, uint) {
+	epochHeight := epoch * bitcoinDifficultyEpochLength
+
+	return epochHeight - proofLength, epochHeight + proofLength - 1
+}
+
+// proveEpoch submits the retarget proof of the given epoch built from the
+// given range of block headers and waits until the epoch is proven in the
+// Bitcoin difficulty chain.
+func (bdm *bitcoinDifficultyMaintainer) proveEpoch(
+	ctx context.Context,
+	epoch uint,
+	firstBlockHeaderHeight uint,
+	lastBlockHeaderHeight uint,
+) error {
+	headers, err := bdm.getBlockHeaders(
+		firstBlockHeaderHeight,
+		lastBlockHeaderHeight,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"failed to get block headers from Bitcoin chain: [%w]",
+			err,
+		)
+	}
+
+	if bdm.config.DisableProxy {
+		if err := bdm.chain.Retarget(headers); err != nil {
+			return fmt.Errorf(
+				"failed to submit block headers from range [%d:%d] via "+
+					"Retarget: [%w]",
+				firstBlockHeaderHeight,
+				lastBlockHeaderHeight,
+				err,
+			)
+		}
+	} else {
+		if err := bdm.chain.RetargetWithRefund(headers); err != nil {
+			return fmt.Errorf(
+				"failed to submit block headers from range [%d:%d] via "+
+					"RetargetWithRefund: [%w]",
+				firstBlockHeaderHeight,
+				lastBlockHeaderHeight,
+				err,
+			)
+		}
+	}
+
+	if err := bdm.waitForCurrentEpochUpdate(ctx, uint64(epoch)); err != nil {
+		return fmt.Errorf(
+			"error while waiting for current Bitcoin difficulty epoch "+
+				"update: [%w]",
+			err,
+		)
+	}
+
+	logger.Infof(
+		"successfully submitted block headers [%d:%d] to the Bitcoin "+
+			"difficulty chain; the current proven epoch is [%d]",
+		firstBlockHeaderHeight,
+		lastBlockHeaderHeight,
+		epoch,
+	)
+
+	return nil
+}
+
+// manualRetarget proves the given Bitcoin blockchain epoch in the Bitcoin
+// difficulty chain. The Bitcoin difficulty chain accepts epochs in order so,
+// the given epoch must directly follow the current proven epoch.
+func (bdm *bitcoinDifficultyMaintainer) manualRetarget(
+	ctx context.Context,
+	epochNumber uint64,
+) error {
+	if err := bdm.verifySubmissionEligibility(); err != nil {
+		return fmt.Errorf(
+			"cannot verify submission eligibility: [%w]",
+			err,
+		)
+	}
+
+	currentEpoch, err := bdm.chain.CurrentEpoch()
+	if err != nil {
+		return fmt.Errorf("failed to get current epoch: [%w]", err)
+	}
+
+	if epochNumber <= currentEpoch {
+		return fmt.Errorf(
+			"epoch [%d] has already been proven; the current proven "+
+				"epoch is [%d]",
+			epochNumber,
+			currentEpoch,
+		)
+	}
+
+	if epochNumber > currentEpoch+1 {
+		return fmt.Errorf(
+			"epoch [%d] cannot be proven before epoch [%d]; epochs must "+
+				"be proven in order",
+			epochNumber,
+			currentEpoch+1,
+		)
+	}
+
+	proofLength, err := bdm.chain.ProofLength()
+	if err != nil {
+		return fmt.Errorf("failed to get proof length: [%w]", err)
+	}
+
+	firstBlockHeaderHeight, lastBlockHeaderHeight := retargetHeadersRange(
+		uint(epochNumber),
+		uint(proofLength),
+	)
+
+	currentBlockHeight, err := bdm.btcChain.GetLatestBlockHeight()
+	if err != nil {
+		return fmt.Errorf("failed to get latest block height: [%w]", err)
+	}
+
+	if currentBlockHeight < lastBlockHeaderHeight {
+		return fmt.Errorf(
+			"not enough blocks to prove epoch [%d]; block headers range "+
+				"ends at [%d] but the latest block height is [%d]",
+			epochNumber,
+			lastBlockHeaderHeight,
+			currentBlockHeight,
+		)
+	}
+
+	return bdm.proveEpoch(
+		ctx,
+		uint(epochNumber),
+		firstBlockHeaderHeight,
+		lastBlockHeaderHeight,
+	)
+}
+
 // getBlockHeaders returns block headers from the given range.
 func (bdm *bitcoinDifficultyMaintainer) getBlockHeaders(
 	firstHeaderHeight,
@@ -2,6 +2,7 @@ package btcdiff
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"testing"
 	"time"
@@ -98,6 +99,48 @@ func TestVerifySubmissionEligibility(t *testing.T) {
 	}
 }
 
+func TestVerifySubmissionEligibility_AuthorizationCheckError(t *testing.T) {
+	authorizationError := fmt.Errorf("authorization check error")
+
+	for _, disableProxy := range []bool{false, true} {
+		t.Run(fmt.Sprintf("disabled proxy: %v", disableProxy), func(t *testing.T) {
+			difficultyChain := connectLocalBitcoinDifficultyChain()
+			operatorAddress := difficultyChain.Signing().Address()
+
+			// The operator is authorized both ways so, only the error
+			// can make the eligibility check fail.
+			difficultyChain.SetReady(true)
+			difficultyChain.SetAuthorizedOperator(operatorAddress, true)
+			difficultyChain.SetAuthorizedForRefundOperator(operatorAddress, true)
+			difficultyChain.SetAuthorizationError(authorizationError)
+
+			bitcoinDifficultyMaintainer := &bitcoinDifficultyMaintainer{
+				config: Config{
+					DisableProxy:       disableProxy,
+					IdleBackOffTime:    bitcoinDifficultyDefaultIdleBackOffTime,
+					RestartBackOffTime: bitcoinDifficultyDefaultRestartBackoffTime,
+				},
+				btcChain: nil,
+				chain:    difficultyChain,
+			}
+
+			err := bitcoinDifficultyMaintainer.verifySubmissionEligibility()
+			testutils.AssertAnyErrorInChainMatchesTarget(
+				t,
+				authorizationError,
+				err,
+			)
+
+			difficultyChain.SetAuthorizationError(nil)
+
+			err = bitcoinDifficultyMaintainer.verifySubmissionEligibility()
+			if err != nil {
+				t.Errorf("unexpected error: [%v]", err)
+			}
+		})
+	}
+}
+
 func TestProveNextEpoch(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -275,6 +318,179 @@ func TestProveNextEpoch(t *testing.T) {
 	}
 }
 
+func TestRetargetHeadersRange(t *testing.T) {
+	tests := map[string]struct {
+		epoch               uint
+		proofLength         uint
+		expectedFirstHeight uint
+		expectedLastHeight  uint
+	}{
+		"proof length of 3": {
+			epoch:               300,
+			proofLength:         3,
+			expectedFirstHeight: 604797,
+			expectedLastHeight:  604802,
+		},
+		"proof length of 1": {
+			epoch:               300,
+			proofLength:         1,
+			expectedFirstHeight: 604799,
+			expectedLastHeight:  604800,
+		},
+		"other epoch": {
+			epoch:               259,
+			proofLength:         3,
+			expectedFirstHeight: 522141,
+			expectedLastHeight:  522146,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			firstHeight, lastHeight := retargetHeadersRange(
+				test.epoch,
+				test.proofLength,
+			)
+
+			testutils.AssertUintsEqual(
+				t,
+				"first block header height",
+				uint64(test.expectedFirstHeight),
+				uint64(firstHeight),
+			)
+			testutils.AssertUintsEqual(
+				t,
+				"last block header height",
+				uint64(test.expectedLastHeight),
+				uint64(lastHeight),
+			)
+		})
+	}
+}
+
+func TestManualRetarget(t *testing.T) {
+	blockHeaders := make(map[uint]*bitcoin.BlockHeader)
+	for height := uint(604797); height <= 604802; height++ {
+		bits := uint32(1111111)
+		if height >= 604800 {
+			bits = 2222222
+		}
+
+		blockHeaders[height] = &bitcoin.BlockHeader{
+			Bits:  bits,
+			Nonce: uint32(height),
+		}
+	}
+
+	tests := map[string]struct {
+		currentEpoch  uint64
+		epochNumber   uint64
+		blockHeaders  map[uint]*bitcoin.BlockHeader
+		expectedError error
+	}{
+		"next epoch": {
+			currentEpoch:  299,
+			epochNumber:   300,
+			blockHeaders:  blockHeaders,
+			expectedError: nil,
+		},
+		"epoch already proven": {
+			currentEpoch: 300,
+			epochNumber:  300,
+			blockHeaders: blockHeaders,
+			expectedError: fmt.Errorf(
+				"epoch [300] has already been proven; the current proven " +
+					"epoch is [300]",
+			),
+		},
+		"epoch out of order": {
+			currentEpoch: 298,
+			epochNumber:  300,
+			blockHeaders: blockHeaders,
+			expectedError: fmt.Errorf(
+				"epoch [300] cannot be proven before epoch [299]; epochs " +
+					"must be proven in order",
+			),
+		},
+		"not enough blocks": {
+			currentEpoch: 299,
+			epochNumber:  300,
+			blockHeaders: map[uint]*bitcoin.BlockHeader{
+				604801: blockHeaders[604801],
+			},
+			expectedError: fmt.Errorf(
+				"not enough blocks to prove epoch [300]; block headers " +
+					"range ends at [604802] but the latest block height " +
+					"is [604801]",
+			),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			ctx, cancelCtx := context.WithCancel(context.Background())
+			defer cancelCtx()
+
+			btcChain := connectLocalBitcoinChain()
+			btcChain.SetBlockHeaders(test.blockHeaders)
+
+			difficultyChain := connectLocalBitcoinDifficultyChain()
+			difficultyChain.SetReady(true)
+			difficultyChain.SetAuthorizedOperator(
+				difficultyChain.Signing().Address(),
+				true,
+			)
+			difficultyChain.SetCurrentEpoch(test.currentEpoch)
+			difficultyChain.SetProofLength(3)
+
+			err := ManualRetarget(
+				ctx,
+				Config{DisableProxy: true},
+				btcChain,
+				difficultyChain,
+				test.epochNumber,
+			)
+			if !reflect.DeepEqual(test.expectedError, err) {
+				t.Fatalf(
+					"unexpected error\nexpected: %v\nactual:   %v\n",
+					test.expectedError,
+					err,
+				)
+			}
+
+			if test.expectedError != nil {
+				testutils.AssertIntsEqual(
+					t,
+					"retarget events count",
+					0,
+					len(difficultyChain.RetargetEvents()),
+				)
+				return
+			}
+
+			retargetEvents := difficultyChain.RetargetEvents()
+			testutils.AssertIntsEqual(
+				t,
+				"retarget events count",
+				1,
+				len(retargetEvents),
+			)
+			testutils.AssertUintsEqual(
+				t,
+				"old difficulty",
+				uint64(blockHeaders[604799].Bits),
+				uint64(retargetEvents[0].oldDifficulty),
+			)
+			testutils.AssertUintsEqual(
+				t,
+				"new difficulty",
+				uint64(blockHeaders[604800].Bits),
+				uint64(retargetEvents[0].newDifficulty),
+			)
+		})
+	}
+}
+
 func TestGetBlockHeaders(t *testing.T) {
 	btcChain := connectLocalBitcoinChain()
 
@@ -540,6 +756,79 @@ func TestProveEpochs_Successful(t *testing.T) {
 	testutils.AssertAnyErrorInChainMatchesTarget(t, context.Canceled, err)
 }
 
+func TestProveEpochs_CatchUp(t *testing.T) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	difficultyChain := connectLocalBitcoinDifficultyChain()
+	maintainerAddress := difficultyChain.Signing().Address()
+
+	difficultyChain.SetReady(true)
+	difficultyChain.SetAuthorizedOperator(
+		maintainerAddress,
+		true,
+	)
+	difficultyChain.SetProofLength(1)
+	difficultyChain.SetCurrentEpoch(297)
+
+	btcChain := connectLocalBitcoinChain()
+
+	// Set one block header on each side of the retargets of epochs 298, 299
+	// and 300 so the difficulty chain is three epochs behind.
+	blockHeaders := make(map[uint]*bitcoin.BlockHeader)
+	for epoch := uint(298); epoch <= 300; epoch++ {
+		epochHeight := epoch * bitcoinDifficultyEpochLength
+
+		blockHeaders[epochHeight-1] = &bitcoin.BlockHeader{
+			Bits: uint32(epoch - 1),
+		}
+		blockHeaders[epochHeight] = &bitcoin.BlockHeader{
+			Bits: uint32(epoch),
+		}
+	}
+	btcChain.SetBlockHeaders(blockHeaders)
+
+	bitcoinDifficultyMaintainer := &bitcoinDifficultyMaintainer{
+		btcChain: btcChain,
+		chain:    difficultyChain,
+		config: Config{
+			DisableProxy:       true,
+			IdleBackOffTime:    time.Hour,
+			RestartBackOffTime: time.Hour,
+		},
+	}
+
+	// The idle back-off is much longer than the test so, all missing epochs
+	// can be proven only if the maintainer does not back off between them.
+	go func() {
+		time.Sleep(time.Second)
+		cancelCtx()
+	}()
+
+	err := bitcoinDifficultyMaintainer.proveEpochs(ctx)
+	testutils.AssertAnyErrorInChainMatchesTarget(t, context.Canceled, err)
+
+	expectedRetargetEvents := []*RetargetEvent{
+		{oldDifficulty: 297, newDifficulty: 298},
+		{oldDifficulty: 298, newDifficulty: 299},
+		{oldDifficulty: 299, newDifficulty: 300},
+	}
+	if !reflect.DeepEqual(
+		expectedRetargetEvents,
+		difficultyChain.RetargetEvents(),
+	) {
+		t.Errorf(
+			"unexpected retarget events\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			expectedRetargetEvents,
+			difficultyChain.RetargetEvents(),
+		)
+	}
+
+	testutils.AssertUintsEqual(t, "current epoch", 300, difficultyChain.currentEpoch)
+}
+
 func TestBitcoinDifficultyMaintainer_Integration(t *testing.T) {
 	type authorizationFunc func(
 		difficultyChain *localBitcoinDifficultyChain,
@@ -25,6 +25,9 @@ type localBitcoinDifficultyChain struct {
 	authorizedOperators          map[chain.Address]bool
 	authorizedForRefundOperators map[chain.Address]bool
 
+	// authorizationError, if set, is returned by all authorization checks.
+	authorizationError error
+
 	retargetEvents           []*RetargetEvent
 	retargetWithRefundEvents []*RetargetEvent
 }
@@ -40,6 +43,10 @@ func (lbdc *localBitcoinDifficultyChain) Ready() (bool, error) {
 func (lbdc *localBitcoinDifficultyChain) IsAuthorized(
 	address chain.Address,
 ) (bool, error) {
+	if lbdc.authorizationError != nil {
+		return false, lbdc.authorizationError
+	}
+
 	return lbdc.authorizedOperators[address], nil
 }
 
@@ -50,6 +57,10 @@ func (lbdc *localBitcoinDifficultyChain) IsAuthorized(
 func (lbdc *localBitcoinDifficultyChain) IsAuthorizedForRefund(
 	address chain.Address,
 ) (bool, error) {
+	if lbdc.authorizationError != nil {
+		return false, lbdc.authorizationError
+	}
+
 	return lbdc.authorizedForRefundOperators[address], nil
 }
 
@@ -143,6 +154,12 @@ func (lbdc *localBitcoinDifficultyChain) SetAuthorizedForRefundOperator(
 	lbdc.authorizedForRefundOperators[operatorAddress] = authorized
 }
 
+// SetAuthorizationError sets the error returned by all authorization checks.
+// Passing nil makes the checks succeed again.
+func (lbdc *localBitcoinDifficultyChain) SetAuthorizationError(err error) {
+	lbdc.authorizationError = err
+}
+
 // SetCurrentEpoch sets the current proven epoch in the chain.
 func (lbdc *localBitcoinDifficultyChain) SetCurrentEpoch(currentEpoch uint64) {
 	lbdc.currentEpoch = currentEpoch
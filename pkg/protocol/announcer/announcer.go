@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/keep-network/keep-core/pkg/net"
 	"github.com/keep-network/keep-core/pkg/protocol/announcer/gen/pb"
@@ -69,6 +70,21 @@ type Announcer struct {
 	protocolID          string
 	broadcastChannel    net.BroadcastChannel
 	membershipValidator *group.MembershipValidator
+	timeout             time.Duration
+}
+
+// Option allows to set optional parameters of the Announcer.
+type Option func(announcer *Announcer)
+
+// WithTimeout sets the maximum time the Announcer listens for readiness
+// announcements from other group members. Once the timeout elapses, Announce
+// returns members that announced their readiness so far, even if the ctx
+// passed to Announce is not done yet. A zero or negative timeout means that
+// Announce is bounded only by its ctx.
+func WithTimeout(timeout time.Duration) Option {
+	return func(announcer *Announcer) {
+		announcer.timeout = timeout
+	}
 }
 
 // RegisterUnmarshaller initializes the given broadcast channel to be able to
@@ -82,24 +98,32 @@ func RegisterUnmarshaller(channel net.BroadcastChannel) {
 // New creates a new instance of the Announcer. It expects a unique protocol
 // identifier, a broadcast channel configured to mediate between group members,
 // and a membership validator configured to validate the group membership of
-// announcements senders.
+// announcements senders. Optional parameters can be set using options.
 func New(
 	protocolID string,
 	broadcastChannel net.BroadcastChannel,
 	membershipValidator *group.MembershipValidator,
+	options ...Option,
 ) *Announcer {
-	return &Announcer{
+	announcer := &Announcer{
 		protocolID:          protocolID,
 		broadcastChannel:    broadcastChannel,
 		membershipValidator: membershipValidator,
 	}
+
+	for _, option := range options {
+		option(announcer)
+	}
+
+	return announcer
 }
 
 // Announce sends the member's readiness announcement for the given protocol
 // session and listens for announcements from other group members. It returns a
 // list of unique members indexes that are ready for the given attempt,
 // including the executing member's index. The list is sorted in ascending order.
-// This function blocks until the ctx passed as argument is done.
+// This function blocks until the ctx passed as argument is done or the
+// announcement timeout set using WithTimeout elapses, whichever comes first.
 func (a *Announcer) Announce(
 	ctx context.Context,
 	memberIndex group.MemberIndex,
@@ -108,6 +132,12 @@ func (a *Announcer) Announce(
 	[]group.MemberIndex,
 	error,
 ) {
+	if a.timeout > 0 {
+		var cancelCtx context.CancelFunc
+		ctx, cancelCtx = context.WithTimeout(ctx, a.timeout)
+		defer cancelCtx()
+	}
+
 	messagesChan := make(chan net.Message, announceReceiveBuffer)
 
 	a.broadcastChannel.Recv(ctx, func(message net.Message) {
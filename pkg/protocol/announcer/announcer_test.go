@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"sync"
 	"testing"
+	"time"
 
 	fuzz "github.com/google/gofuzz"
 
@@ -228,6 +229,119 @@ func TestAnnouncer(t *testing.T) {
 	}
 }
 
+func TestAnnouncer_WithTimeout(t *testing.T) {
+	protocolID := "protocol-test"
+	groupSize := 5
+	honestThreshold := 3
+	timeout := 3 * local.RetransmissionTick
+
+	operatorPrivateKey, operatorPublicKey, err := operator.GenerateKeyPair(
+		local_v1.DefaultCurve,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	localChain := local_v1.ConnectWithKey(
+		groupSize,
+		honestThreshold,
+		operatorPrivateKey,
+	)
+
+	operatorAddress, err := localChain.Signing().PublicKeyToAddress(
+		operatorPublicKey,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var operators []chain.Address
+	for i := 0; i < groupSize; i++ {
+		operators = append(operators, operatorAddress)
+	}
+
+	localProvider := local.ConnectWithKey(operatorPublicKey)
+
+	broadcastChannel, err := localProvider.BroadcastChannelFor("timeout-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	membershipValidator := group.NewMembershipValidator(
+		&testutils.MockLogger{},
+		operators,
+		localChain.Signing(),
+	)
+
+	RegisterUnmarshaller(broadcastChannel)
+
+	announcer := New(
+		protocolID,
+		broadcastChannel,
+		membershipValidator,
+		WithTimeout(timeout),
+	)
+
+	announcingMembersIndexes := []group.MemberIndex{2, 4}
+
+	results := make(map[group.MemberIndex][]group.MemberIndex)
+	resultsMutex := sync.Mutex{}
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(announcingMembersIndexes))
+
+	start := time.Now()
+
+	for _, announcingMemberIndex := range announcingMembersIndexes {
+		go func(memberIndex group.MemberIndex) {
+			defer wg.Done()
+
+			// The parent context is never done so Announce must return
+			// once the announcer's timeout elapses.
+			readyMembersIndexes, err := announcer.Announce(
+				context.Background(),
+				memberIndex,
+				"session-test",
+			)
+			if err != nil {
+				t.Errorf("unexpected error: [%v]", err)
+				return
+			}
+
+			resultsMutex.Lock()
+			results[memberIndex] = readyMembersIndexes
+			resultsMutex.Unlock()
+		}(announcingMemberIndex)
+	}
+
+	wg.Wait()
+
+	elapsed := time.Since(start)
+	if elapsed < timeout {
+		t.Errorf(
+			"announce returned before the timeout elapsed\n"+
+				"timeout: [%v]\n"+
+				"elapsed: [%v]",
+			timeout,
+			elapsed,
+		)
+	}
+
+	expectedResults := map[group.MemberIndex][]group.MemberIndex{
+		2: {2, 4},
+		4: {2, 4},
+	}
+	if !reflect.DeepEqual(expectedResults, results) {
+		t.Errorf(
+			"unexpected results\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			expectedResults,
+			results,
+		)
+	}
+}
+
 func TestUnreadyMembers(t *testing.T) {
 	tests := map[string]struct {
 		readyMembers []group.MemberIndex
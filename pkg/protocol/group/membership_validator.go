@@ -2,6 +2,8 @@ package group
 
 import (
 	"github.com/ipfs/go-log/v2"
+	"golang.org/x/exp/slices"
+
 	"github.com/keep-network/keep-core/pkg/chain"
 	"github.com/keep-network/keep-core/pkg/operator"
 )
@@ -63,26 +65,29 @@ func (mv *MembershipValidator) IsInGroup(
 // IsValidMembership returns true if party with the given public key has
 // been selected to the group at the given position. If the position does
 // not match function returns false. The same happens when the party was
-// not selected to the group.
+// not selected to the group. An operator controlling multiple members of the
+// group is a valid sender for any of its member indexes.
 func (mv *MembershipValidator) IsValidMembership(
 	memberID MemberIndex,
 	publicKey []byte,
 ) bool {
-	address := mv.signing.PublicKeyBytesToAddress(publicKey).String()
-
-	positions, isInGroup := mv.members[address]
+	address := mv.signing.PublicKeyBytesToAddress(publicKey)
 
-	if !isInGroup {
-		return false
-	}
+	return slices.Contains(mv.OperatorIndexes(address), memberID)
+}
 
-	index := int(memberID - 1)
+// OperatorIndexes returns all member indexes the operator with the given
+// address holds in the group, in ascending order. If the operator was not
+// selected to the group, the returned slice is empty.
+func (mv *MembershipValidator) OperatorIndexes(
+	address chain.Address,
+) []MemberIndex {
+	positions := mv.members[address.String()]
 
-	for _, position := range positions {
-		if index == position {
-			return true
-		}
+	indexes := make([]MemberIndex, len(positions))
+	for i, position := range positions {
+		indexes[i] = MemberIndex(position + 1)
 	}
 
-	return false
+	return indexes
 }
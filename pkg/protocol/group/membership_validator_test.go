@@ -1,8 +1,12 @@
 package group
 
 import (
+	"fmt"
+	"reflect"
 	"testing"
 
+	"golang.org/x/exp/slices"
+
 	"github.com/keep-network/keep-core/internal/testutils"
 
 	"github.com/keep-network/keep-core/pkg/chain"
@@ -79,6 +83,82 @@ func TestIsValidMembership(t *testing.T) {
 	}
 }
 
+func TestOperatorIndexes(t *testing.T) {
+	localChain := local_v1.Connect(3, 3)
+	signing := localChain.Signing()
+
+	publicKey1 := generatePublicKeyBytes(t)
+	publicKey2 := generatePublicKeyBytes(t)
+	publicKey3 := generatePublicKeyBytes(t)
+	publicKey4 := generatePublicKeyBytes(t)
+
+	address1 := signing.PublicKeyBytesToAddress(publicKey1)
+	address2 := signing.PublicKeyBytesToAddress(publicKey2)
+	address3 := signing.PublicKeyBytesToAddress(publicKey3)
+	address4 := signing.PublicKeyBytesToAddress(publicKey4)
+
+	validator := NewMembershipValidator(
+		&testutils.MockLogger{},
+		[]chain.Address{address3, address1, address2, address3, address2, address3},
+		signing,
+	)
+
+	var tests = map[string]struct {
+		address         chain.Address
+		publicKey       []byte
+		expectedIndexes []MemberIndex
+	}{
+		"operator with 1 group slot": {
+			address:         address1,
+			publicKey:       publicKey1,
+			expectedIndexes: []MemberIndex{2},
+		},
+		"operator with 2 group slots": {
+			address:         address2,
+			publicKey:       publicKey2,
+			expectedIndexes: []MemberIndex{3, 5},
+		},
+		"operator with 3 group slots": {
+			address:         address3,
+			publicKey:       publicKey3,
+			expectedIndexes: []MemberIndex{1, 4, 6},
+		},
+		"operator not in group": {
+			address:         address4,
+			publicKey:       publicKey4,
+			expectedIndexes: []MemberIndex{},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			indexes := validator.OperatorIndexes(test.address)
+
+			if !reflect.DeepEqual(test.expectedIndexes, indexes) {
+				t.Errorf(
+					"unexpected indexes\nexpected: %v\nactual:   %v",
+					test.expectedIndexes,
+					indexes,
+				)
+			}
+
+			for memberIndex := MemberIndex(1); memberIndex <= 6; memberIndex++ {
+				expectedValid := slices.Contains(
+					test.expectedIndexes,
+					memberIndex,
+				)
+
+				testutils.AssertBoolsEqual(
+					t,
+					fmt.Sprintf("membership validity for index [%v]", memberIndex),
+					expectedValid,
+					validator.IsValidMembership(memberIndex, test.publicKey),
+				)
+			}
+		})
+	}
+}
+
 func generatePublicKey(t *testing.T) *operator.PublicKey {
 	_, operatorPublicKey, err := operator.GenerateKeyPair(local_v1.DefaultCurve)
 	if err != nil {
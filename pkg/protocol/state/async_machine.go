@@ -41,6 +41,23 @@ type AsyncMachine struct {
 	ctx          context.Context
 	channel      net.BroadcastChannel
 	initialState AsyncState // first state from which execution starts
+
+	stateDurationObserver func(state AsyncState, duration time.Duration)
+}
+
+// AsyncMachineOption allows to set optional parameters of the AsyncMachine.
+type AsyncMachineOption func(machine *AsyncMachine)
+
+// WithStateDurationObserver sets a function that is called with each state
+// completed by the AsyncMachine and the wall-clock time the state took, from
+// the start of its initiation until it was ready to transition. The observer
+// is called synchronously by the machine so it should return quickly.
+func WithStateDurationObserver(
+	observer func(state AsyncState, duration time.Duration),
+) AsyncMachineOption {
+	return func(machine *AsyncMachine) {
+		machine.stateDurationObserver = observer
+	}
 }
 
 // NewAsyncMachine returns a new protocol asynchronous state machine
@@ -56,13 +73,20 @@ func NewAsyncMachine(
 	ctx context.Context,
 	channel net.BroadcastChannel,
 	initialState AsyncState,
+	options ...AsyncMachineOption,
 ) *AsyncMachine {
-	return &AsyncMachine{
+	machine := &AsyncMachine{
 		logger:       logger,
 		ctx:          ctx,
 		channel:      channel,
 		initialState: initialState,
 	}
+
+	for _, option := range options {
+		option(machine)
+	}
+
+	return machine
 }
 
 // Execute state machine starting with initial state up to finalization. It
@@ -73,12 +97,18 @@ func (am *AsyncMachine) Execute() (AsyncState, error) {
 
 	recvChan := make(chan net.Message, asyncReceiveBuffer)
 	handler := func(msg net.Message) {
-		recvChan <- msg
+		// Do not block the broadcast channel's handler goroutine forever if
+		// the buffer is full and the state machine is no longer executing.
+		select {
+		case recvChan <- msg:
+		case <-recvCtx.Done():
+		}
 	}
 	am.channel.Recv(recvCtx, handler)
 
 	currentState := am.initialState
 
+	stateStartTime := time.Now()
 	onStateDone := asyncStateTransition(
 		am.ctx,
 		am.logger,
@@ -107,6 +137,13 @@ func (am *AsyncMachine) Execute() (AsyncState, error) {
 				)
 			}
 
+			if am.stateDurationObserver != nil {
+				am.stateDurationObserver(
+					currentState,
+					time.Since(stateStartTime),
+				)
+			}
+
 			nextState, err := currentState.Next()
 			if err != nil {
 				return nil, fmt.Errorf(
@@ -126,6 +163,7 @@ func (am *AsyncMachine) Execute() (AsyncState, error) {
 			}
 
 			currentState = nextState
+			stateStartTime = time.Now()
 			onStateDone = asyncStateTransition(
 				am.ctx,
 				am.logger,
@@ -154,7 +192,10 @@ func asyncStateTransition(
 		currentState,
 	)
 
-	onDone := make(chan error)
+	// The channel is buffered so that the initiation error can be delivered
+	// even if the state machine stopped listening because the context is
+	// done. Otherwise, the goroutine below would block forever.
+	onDone := make(chan error, 1)
 
 	go func() {
 		err := currentState.Initiate(ctx)
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"runtime"
 	"strconv"
 	"strings"
 	"sync"
@@ -129,6 +130,60 @@ func TestAsyncExecute_ContextCancelled(t *testing.T) {
 	testutils.AssertErrorsSame(t, context.Canceled, err)
 }
 
+// TestAsyncExecute_ContextCancelledNoGoroutineLeak ensures no goroutine
+// started by the state machine outlives the execution when the context got
+// cancelled at a random state boundary while the state initiation was still
+// in progress.
+func TestAsyncExecute_ContextCancelledNoGoroutineLeak(t *testing.T) {
+	provider := netlocal.Connect()
+	channel, err := provider.BroadcastChannelFor("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var logger = &testutils.MockLogger{}
+
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	for i := 0; i < 10; i++ {
+		goroutinesBefore := runtime.NumGoroutine()
+
+		ctx, cancelCtx := context.WithCancel(context.Background())
+
+		initiatedChan := make(chan struct{})
+		initialState := &cancellableInitiateState{
+			remainingStates: rng.Intn(5),
+			initiatedChan:   initiatedChan,
+		}
+
+		go func() {
+			<-initiatedChan
+			cancelCtx()
+		}()
+
+		_, err = NewAsyncMachine(logger, ctx, channel, initialState).Execute()
+		testutils.AssertErrorsSame(t, context.Canceled, err)
+
+		// Give the goroutines some time to exit.
+		deadline := time.Now().Add(1 * time.Second)
+		for runtime.NumGoroutine() > goroutinesBefore &&
+			time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		if goroutinesAfter := runtime.NumGoroutine(); goroutinesAfter > goroutinesBefore {
+			t.Fatalf(
+				"goroutines leaked in iteration [%v]\n"+
+					"before: [%v]\n"+
+					"after:  [%v]",
+				i,
+				goroutinesBefore,
+				goroutinesAfter,
+			)
+		}
+	}
+}
+
 // TestAsyncExecute_FailingStateInitiate ensures the state machine fails the
 // execution when initiation of a state returned an error.
 func TestAsyncExecute_FailingStateInitiate(t *testing.T) {
@@ -181,6 +236,57 @@ func TestAsyncExecute_InitiateBeforeTransitioning(t *testing.T) {
 	)
 }
 
+// TestAsyncExecute_StateDurationObserver ensures the state duration observer
+// is called for each completed state with the time the state took.
+func TestAsyncExecute_StateDurationObserver(t *testing.T) {
+	provider := netlocal.Connect()
+	channel, err := provider.BroadcastChannelFor("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	var logger = &testutils.MockLogger{}
+
+	initialState := &simpleLoggingState{}
+
+	var observedStates []AsyncState
+	var observedDurations []time.Duration
+
+	_, err = NewAsyncMachine(
+		logger,
+		ctx,
+		channel,
+		initialState,
+		WithStateDurationObserver(
+			func(state AsyncState, duration time.Duration) {
+				observedStates = append(observedStates, state)
+				observedDurations = append(observedDurations, duration)
+			},
+		),
+	).Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutils.AssertIntsEqual(t, "observed states count", 1, len(observedStates))
+
+	if observedStates[0] != initialState {
+		t.Errorf("unexpected observed state: [%T]", observedStates[0])
+	}
+
+	// The state's initiation holds on for three transition check intervals.
+	if minDuration := 3 * transitionCheckInterval; observedDurations[0] < minDuration {
+		t.Errorf(
+			"observed duration [%v] is shorter than expected minimum [%v]",
+			observedDurations[0],
+			minDuration,
+		)
+	}
+}
+
 //
 // State used for TestAsyncExecute_InitiateBeforeTransitioning
 //
@@ -216,6 +322,43 @@ func (sls *simpleLoggingState) MemberIndex() group.MemberIndex {
 	return group.MemberIndex(1)
 }
 
+//
+// State used for TestAsyncExecute_ContextCancelledNoGoroutineLeak
+//
+
+// cancellableInitiateState transitions through remainingStates states
+// immediately. The last state signals it is being initiated and blocks the
+// initiation until the context is done, returning the context error.
+type cancellableInitiateState struct {
+	remainingStates int
+	initiatedChan   chan struct{}
+}
+
+func (cis *cancellableInitiateState) CanTransition() bool {
+	return cis.remainingStates > 0
+}
+func (cis *cancellableInitiateState) Initiate(ctx context.Context) error {
+	if cis.remainingStates > 0 {
+		return nil
+	}
+
+	close(cis.initiatedChan)
+	<-ctx.Done()
+	return ctx.Err()
+}
+func (cis *cancellableInitiateState) Receive(msg net.Message) error {
+	return nil
+}
+func (cis *cancellableInitiateState) Next() (AsyncState, error) {
+	return &cancellableInitiateState{
+		remainingStates: cis.remainingStates - 1,
+		initiatedChan:   cis.initiatedChan,
+	}, nil
+}
+func (cis *cancellableInitiateState) MemberIndex() group.MemberIndex {
+	return group.MemberIndex(1)
+}
+
 //
 // State used for TestAsyncExecute_FailingState
 //
@@ -41,6 +41,9 @@ func (lbc *localBitcoinChain) GetTransaction(
 func (lbc *localBitcoinChain) GetTransactionConfirmations(
 	transactionHash bitcoin.Hash,
 ) (uint, error) {
+	lbc.transactionsMutex.Lock()
+	defer lbc.transactionsMutex.Unlock()
+
 	for index, transaction := range lbc.transactions {
 		if transaction.Hash() == transactionHash {
 			confirmations := len(lbc.transactions) - index
@@ -1,7 +1,9 @@
 package tbtc
 
 import (
+	"context"
 	"crypto/ecdsa"
+	"fmt"
 	"math/big"
 	"time"
 
@@ -42,6 +44,14 @@ type GroupSelectionResult struct {
 	OperatorsAddresses chain.Addresses
 }
 
+// GasPriceOracle provides gas price suggestions used to price on-chain
+// submissions according to the current network conditions.
+type GasPriceOracle interface {
+	// SuggestGasPrice returns the gas price, in wei, suggested for
+	// a transaction submitted at the moment.
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+}
+
 // DistributedKeyGenerationChain defines the subset of the TBTC chain
 // interface that pertains specifically to group formation's distributed key
 // generation process.
@@ -60,6 +70,14 @@ type DistributedKeyGenerationChain interface {
 		filter *DKGStartedEventFilter,
 	) ([]*DKGStartedEvent, error)
 
+	// PastRelayEntryRequestedEvents fetches past beacon relay entry request
+	// events according to the provided filter or unfiltered if the filter
+	// is nil. Returned events are sorted by the block number in the
+	// ascending order, i.e. the latest event is at the end of the slice.
+	PastRelayEntryRequestedEvents(
+		filter *BeaconRelayEntryRequestedEventFilter,
+	) ([]*BeaconRelayEntryRequestedEvent, error)
+
 	// OnDKGResultSubmitted registers a callback that is invoked when an on-chain
 	// notification of the DKG result submission is seen.
 	OnDKGResultSubmitted(
@@ -78,6 +96,12 @@ type DistributedKeyGenerationChain interface {
 		func(event *DKGResultApprovedEvent),
 	) subscription.EventSubscription
 
+	// OnDKGTimedOut registers a callback that is invoked when an on-chain
+	// notification of the DKG timeout is seen.
+	OnDKGTimedOut(
+		func(event *DKGTimedOutEvent),
+	) subscription.EventSubscription
+
 	// AssembleDKGResult assembles the DKG chain result according to the rules
 	// expected by the given chain.
 	AssembleDKGResult(
@@ -89,12 +113,22 @@ type DistributedKeyGenerationChain interface {
 		groupSelectionResult *GroupSelectionResult,
 	) (*DKGChainResult, error)
 
-	// SubmitDKGResult submits the DKG result to the chain.
-	SubmitDKGResult(dkgResult *DKGChainResult) error
+	// SubmitDKGResult submits the DKG result to the chain. The gasPrice is
+	// the maximum price, in wei, the submitter is willing to pay for a unit
+	// of gas. If gasPrice is nil, the chain's default pricing is used.
+	SubmitDKGResult(dkgResult *DKGChainResult, gasPrice *big.Int) error
+
+	// GasPriceOracle is used to price the DKG result submission.
+	GasPriceOracle
 
 	// GetDKGState returns the current state of the DKG procedure.
 	GetDKGState() (DKGState, error)
 
+	// GetPendingDKGSession returns the DKG session that is currently
+	// awaiting the result and has not timed out yet. Returns nil if there
+	// is no such session.
+	GetPendingDKGSession() (*DKGSession, error)
+
 	// CalculateDKGResultSignatureHash calculates a 32-byte hash that is used
 	// to produce a signature supporting the given groupPublicKey computed
 	// as result of the given DKG process. The misbehavedMembersIndexes parameter
@@ -119,6 +153,30 @@ type DistributedKeyGenerationChain interface {
 
 	// DKGParameters gets the current value of DKG-specific control parameters.
 	DKGParameters() (*DKGParameters, error)
+
+	// PastWalletCreations returns the wallets created on-chain between the
+	// given blocks, i.e. wallets whose DKG results were approved in that
+	// range. The end block is optional; if nil, the range ends at the latest
+	// block. The returned wallets may be closed or terminated already. This
+	// function scans past chain events so, it should be used sparingly.
+	PastWalletCreations(
+		startBlock uint64,
+		endBlock *uint64,
+	) ([]*WalletCreation, error)
+}
+
+// WalletCreation represents the on-chain creation of a wallet from an
+// approved DKG result.
+type WalletCreation struct {
+	WalletPublicKey *ecdsa.PublicKey
+	// Operators holds addresses of operators forming the signing group of
+	// the wallet, ordered by their signing group member index.
+	Operators chain.Addresses
+	// MisbehavedMembersIndexes holds signing group member indexes of members
+	// marked as misbehaved in the DKG result. Those members do not hold key
+	// shares of the wallet.
+	MisbehavedMembersIndexes []group.MemberIndex
+	BlockNumber              uint64
 }
 
 // InactivityClaimedEvent represents an inactivity claimed event. It is emitted
@@ -174,6 +232,60 @@ type InactivityClaimChain interface {
 	GetInactivityClaimNonce(walletID [32]byte) (*big.Int, error)
 }
 
+// MovingFundsTimedOutEvent represents a moving funds timed out event. It is
+// emitted when the given wallet has not completed moving its funds within
+// the protocol timeout.
+type MovingFundsTimedOutEvent struct {
+	WalletPublicKeyHash [20]byte
+	BlockNumber         uint64
+}
+
+// MovingFundsChain defines the subset of the TBTC chain interface that
+// pertains specifically to the moving funds requested by the chain.
+type MovingFundsChain interface {
+	// OnMovingFundsTimedOut registers a callback that is invoked when an
+	// on-chain notification of the moving funds timeout is seen.
+	OnMovingFundsTimedOut(
+		func(event *MovingFundsTimedOutEvent),
+	) subscription.EventSubscription
+}
+
+// FraudChallengeSubmittedEvent represents a fraud challenge submitted event.
+// It is emitted when someone challenges the given wallet claiming that the
+// signature over the given sighash was not produced for a legitimate Bitcoin
+// transaction.
+type FraudChallengeSubmittedEvent struct {
+	WalletPublicKeyHash [20]byte
+	Sighash             [32]byte
+	BlockNumber         uint64
+}
+
+// FraudChain defines the subset of the TBTC chain interface that pertains
+// specifically to the fraud challenges submitted against wallets.
+type FraudChain interface {
+	// OnFraudChallengeSubmitted registers a callback that is invoked when an
+	// on-chain notification of the fraud challenge submission is seen.
+	OnFraudChallengeSubmitted(
+		func(event *FraudChallengeSubmittedEvent),
+	) subscription.EventSubscription
+
+	// SubmitFraudChallengeDefeatWithHeartbeat defeats the fraud challenge
+	// submitted against the given wallet by revealing the heartbeat message
+	// whose signature was challenged.
+	SubmitFraudChallengeDefeatWithHeartbeat(
+		walletPublicKey *ecdsa.PublicKey,
+		heartbeatMessage []byte,
+	) error
+
+	// IsFraudChallengeResolved checks whether the fraud challenge submitted
+	// against the given wallet for the given sighash has been already
+	// resolved, either defeated or notified as timed out.
+	IsFraudChallengeResolved(
+		walletPublicKey *ecdsa.PublicKey,
+		sighash [32]byte,
+	) (bool, error)
+}
+
 // DKGChainResultHash represents a hash of the DKGChainResult. The algorithm
 // used is specific to the chain.
 type DKGChainResultHash [32]byte
@@ -195,6 +307,28 @@ type DKGStartedEvent struct {
 	BlockNumber uint64
 }
 
+// DKGSession represents a DKG session started on-chain.
+type DKGSession struct {
+	Seed       *big.Int
+	StartBlock uint64
+}
+
+// BeaconRelayEntryRequestedEvent represents a beacon relay entry request
+// event.
+type BeaconRelayEntryRequestedEvent struct {
+	RequestID     *big.Int
+	GroupID       uint64
+	PreviousEntry []byte
+	BlockNumber   uint64
+}
+
+// BeaconRelayEntryRequestedEventFilter is a component allowing to filter
+// BeaconRelayEntryRequestedEvent.
+type BeaconRelayEntryRequestedEventFilter struct {
+	StartBlock uint64
+	EndBlock   *uint64
+}
+
 // DKGStartedEventFilter is a component allowing to filter DKGStartedEvent.
 type DKGStartedEventFilter struct {
 	StartBlock uint64
@@ -228,6 +362,16 @@ type DKGResultApprovedEvent struct {
 	BlockNumber uint64
 }
 
+// DKGTimedOutEvent represents a DKG timeout event. It is emitted when no
+// DKG result was approved before the DKG timeout. The StartBlock is the
+// block at which the timed out DKG was started and the BlockNumber is the
+// block at which the timeout was reported.
+type DKGTimedOutEvent struct {
+	Seed        *big.Int
+	StartBlock  uint64
+	BlockNumber uint64
+}
+
 // DKGParameters contains values of DKG-specific control parameters.
 type DKGParameters struct {
 	SubmissionTimeoutBlocks       uint64
@@ -551,11 +695,35 @@ type Chain interface {
 	GetBlockNumberByTimestamp(timestamp uint64) (uint64, error)
 	// GetBlockHashByNumber gets the block hash for the given block number.
 	GetBlockHashByNumber(blockNumber uint64) ([32]byte, error)
+	// IsAvailable returns true if the chain can be reached at the moment.
+	IsAvailable() bool
 
 	sortition.Chain
 	GroupSelectionChain
 	DistributedKeyGenerationChain
 	InactivityClaimChain
+	MovingFundsChain
+	FraudChain
 	BridgeChain
 	WalletProposalValidatorChain
 }
+
+// getCurrentBlock is a convenience function returning the current block
+// of the given chain's block counter.
+func getCurrentBlock(
+	chain interface {
+		BlockCounter() (chain.BlockCounter, error)
+	},
+) (uint64, error) {
+	blockCounter, err := chain.BlockCounter()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get block counter: [%v]", err)
+	}
+
+	currentBlock, err := blockCounter.CurrentBlock()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get current block: [%v]", err)
+	}
+
+	return currentBlock, nil
+}
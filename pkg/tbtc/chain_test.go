@@ -2,6 +2,7 @@ package tbtc
 
 import (
 	"bytes"
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/sha256"
@@ -12,6 +13,7 @@ import (
 	"math/rand"
 	"reflect"
 	"sync"
+	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
@@ -30,6 +32,7 @@ import (
 const (
 	localChainOperatorID = chain.OperatorID(1)
 	stakingProvider      = chain.Address("0x1111111111111111111111111111111111111111")
+	localChainGasPrice   = 20000000000 // 20 Gwei
 )
 
 type movingFundsParameters = struct {
@@ -46,6 +49,11 @@ type movingFundsParameters = struct {
 	sweepTimeoutNotifierRewardMultiplier uint32
 }
 
+type fraudChallengeDefeatSubmission struct {
+	walletPublicKey  *ecdsa.PublicKey
+	heartbeatMessage []byte
+}
+
 type localChain struct {
 	dkgResultSubmissionHandlersMutex sync.Mutex
 	dkgResultSubmissionHandlers      map[int]func(submission *DKGResultSubmittedEvent)
@@ -65,6 +73,10 @@ type localChain struct {
 	dkgState       DKGState
 	dkgResult      *DKGChainResult
 	dkgResultValid bool
+	// pendingDKGSession is the session returned by GetPendingDKGSession.
+	pendingDKGSession *DKGSession
+	// dkgResultGasPrice is the gas price of the last DKG result submission.
+	dkgResultGasPrice *big.Int
 
 	walletsMutex sync.Mutex
 	wallets      map[[20]byte]*WalletChainData
@@ -114,6 +126,23 @@ type localChain struct {
 	eligibleStakesMutex sync.Mutex
 	eligibleStakes      map[chain.Address]*big.Int
 
+	selectGroupCallsMutex sync.Mutex
+	selectGroupCalls      int
+
+	fraudChallengeDefeatSubmissionsMutex sync.Mutex
+	fraudChallengeDefeatSubmissions      []*fraudChallengeDefeatSubmission
+
+	walletCreationsMutex sync.Mutex
+	walletCreations      []*WalletCreation
+
+	relayEntryRequestedEventsMutex sync.Mutex
+	relayEntryRequestedEvents      []*BeaconRelayEntryRequestedEvent
+
+	// unavailable makes the chain report itself as not available. The chain
+	// is available by default.
+	unavailableMutex sync.Mutex
+	unavailable      bool
+
 	blockCounter       chain.BlockCounter
 	operatorPrivateKey *operator.PrivateKey
 }
@@ -190,6 +219,20 @@ func (lc *localChain) setBlockHashByNumber(
 	lc.blocksHashesByNumber[blockNumber] = blockHash
 }
 
+func (lc *localChain) IsAvailable() bool {
+	lc.unavailableMutex.Lock()
+	defer lc.unavailableMutex.Unlock()
+
+	return !lc.unavailable
+}
+
+func (lc *localChain) setAvailable(available bool) {
+	lc.unavailableMutex.Lock()
+	defer lc.unavailableMutex.Unlock()
+
+	lc.unavailable = !available
+}
+
 func (lc *localChain) OperatorToStakingProvider() (chain.Address, bool, error) {
 	return stakingProvider, true, nil
 }
@@ -304,7 +347,12 @@ func (lc *localChain) GetOperatorID(
 }
 
 func (lc *localChain) SelectGroup() (*GroupSelectionResult, error) {
-	panic("not implemented")
+	lc.selectGroupCallsMutex.Lock()
+	defer lc.selectGroupCallsMutex.Unlock()
+
+	lc.selectGroupCalls++
+
+	return nil, fmt.Errorf("group selection not supported")
 }
 
 func (lc *localChain) OnDKGStarted(
@@ -313,6 +361,38 @@ func (lc *localChain) OnDKGStarted(
 	panic("unsupported")
 }
 
+func (lc *localChain) PastRelayEntryRequestedEvents(
+	filter *BeaconRelayEntryRequestedEventFilter,
+) ([]*BeaconRelayEntryRequestedEvent, error) {
+	lc.relayEntryRequestedEventsMutex.Lock()
+	defer lc.relayEntryRequestedEventsMutex.Unlock()
+
+	events := make([]*BeaconRelayEntryRequestedEvent, 0)
+	for _, event := range lc.relayEntryRequestedEvents {
+		if filter != nil {
+			if event.BlockNumber < filter.StartBlock {
+				continue
+			}
+			if filter.EndBlock != nil && event.BlockNumber > *filter.EndBlock {
+				continue
+			}
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (lc *localChain) addRelayEntryRequestedEvent(
+	event *BeaconRelayEntryRequestedEvent,
+) {
+	lc.relayEntryRequestedEventsMutex.Lock()
+	defer lc.relayEntryRequestedEventsMutex.Unlock()
+
+	lc.relayEntryRequestedEvents = append(lc.relayEntryRequestedEvents, event)
+}
+
 func (lc *localChain) PastDKGStartedEvents(
 	filter *DKGStartedEventFilter,
 ) ([]*DKGStartedEvent, error) {
@@ -370,6 +450,20 @@ func (lc *localChain) OnDKGResultApproved(
 	})
 }
 
+func (lc *localChain) GetPendingDKGSession() (*DKGSession, error) {
+	lc.dkgMutex.Lock()
+	defer lc.dkgMutex.Unlock()
+
+	return lc.pendingDKGSession, nil
+}
+
+func (lc *localChain) setPendingDKGSession(session *DKGSession) {
+	lc.dkgMutex.Lock()
+	defer lc.dkgMutex.Unlock()
+
+	lc.pendingDKGSession = session
+}
+
 func (lc *localChain) startDKG() error {
 	lc.dkgMutex.Lock()
 	defer lc.dkgMutex.Unlock()
@@ -429,6 +523,7 @@ func (lc *localChain) AssembleDKGResult(
 
 func (lc *localChain) SubmitDKGResult(
 	dkgResult *DKGChainResult,
+	gasPrice *big.Int,
 ) error {
 	lc.dkgResultSubmissionHandlersMutex.Lock()
 	defer lc.dkgResultSubmissionHandlersMutex.Unlock()
@@ -458,10 +553,16 @@ func (lc *localChain) SubmitDKGResult(
 
 	lc.dkgState = Challenge
 	lc.dkgResult = dkgResult
+	lc.dkgResultGasPrice = gasPrice
 
 	return nil
 }
 
+// SuggestGasPrice always suggests the localChainGasPrice.
+func (lc *localChain) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(localChainGasPrice), nil
+}
+
 func (lc *localChain) GetDKGState() (DKGState, error) {
 	lc.dkgMutex.Lock()
 	defer lc.dkgMutex.Unlock()
@@ -594,6 +695,35 @@ func (lc *localChain) DKGParameters() (*DKGParameters, error) {
 	}, nil
 }
 
+func (lc *localChain) PastWalletCreations(
+	startBlock uint64,
+	endBlock *uint64,
+) ([]*WalletCreation, error) {
+	lc.walletCreationsMutex.Lock()
+	defer lc.walletCreationsMutex.Unlock()
+
+	walletCreations := make([]*WalletCreation, 0)
+	for _, walletCreation := range lc.walletCreations {
+		if walletCreation.BlockNumber < startBlock {
+			continue
+		}
+		if endBlock != nil && walletCreation.BlockNumber > *endBlock {
+			continue
+		}
+
+		walletCreations = append(walletCreations, walletCreation)
+	}
+
+	return walletCreations, nil
+}
+
+func (lc *localChain) addWalletCreation(walletCreation *WalletCreation) {
+	lc.walletCreationsMutex.Lock()
+	defer lc.walletCreationsMutex.Unlock()
+
+	lc.walletCreations = append(lc.walletCreations, walletCreation)
+}
+
 func (lc *localChain) OnInactivityClaimed(
 	handler func(event *InactivityClaimedEvent),
 ) subscription.EventSubscription {
@@ -697,6 +827,59 @@ func (lc *localChain) GetInactivityClaimNonce(walletID [32]byte) (*big.Int, erro
 	return big.NewInt(int64(nonce)), nil
 }
 
+func (lc *localChain) OnDKGTimedOut(
+	handler func(event *DKGTimedOutEvent),
+) subscription.EventSubscription {
+	panic("unsupported")
+}
+
+func (lc *localChain) OnMovingFundsTimedOut(
+	handler func(event *MovingFundsTimedOutEvent),
+) subscription.EventSubscription {
+	panic("unsupported")
+}
+
+func (lc *localChain) OnFraudChallengeSubmitted(
+	handler func(event *FraudChallengeSubmittedEvent),
+) subscription.EventSubscription {
+	panic("unsupported")
+}
+
+func (lc *localChain) SubmitFraudChallengeDefeatWithHeartbeat(
+	walletPublicKey *ecdsa.PublicKey,
+	heartbeatMessage []byte,
+) error {
+	lc.fraudChallengeDefeatSubmissionsMutex.Lock()
+	defer lc.fraudChallengeDefeatSubmissionsMutex.Unlock()
+
+	lc.fraudChallengeDefeatSubmissions = append(
+		lc.fraudChallengeDefeatSubmissions,
+		&fraudChallengeDefeatSubmission{
+			walletPublicKey:  walletPublicKey,
+			heartbeatMessage: heartbeatMessage,
+		},
+	)
+
+	return nil
+}
+
+func (lc *localChain) IsFraudChallengeResolved(
+	walletPublicKey *ecdsa.PublicKey,
+	sighash [32]byte,
+) (bool, error) {
+	lc.fraudChallengeDefeatSubmissionsMutex.Lock()
+	defer lc.fraudChallengeDefeatSubmissionsMutex.Unlock()
+
+	for _, submission := range lc.fraudChallengeDefeatSubmissions {
+		if submission.walletPublicKey.Equal(walletPublicKey) &&
+			bitcoin.ComputeHash(submission.heartbeatMessage) == sighash {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (lc *localChain) PastDepositRevealedEvents(
 	filter *DepositRevealedEventFilter,
 ) ([]*DepositRevealedEvent, error) {
@@ -1492,3 +1675,51 @@ func generateHandlerID() int {
 	// Local chain implementation doesn't require secure randomness.
 	return rand.Int()
 }
+
+func TestGetCurrentBlock(t *testing.T) {
+	localChain := Connect(time.Hour)
+
+	blockCounter, err := localChain.BlockCounter()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedBlock, err := blockCounter.CurrentBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	currentBlock, err := getCurrentBlock(localChain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expectedBlock != currentBlock {
+		t.Errorf(
+			"unexpected current block\nexpected: [%v]\nactual:   [%v]",
+			expectedBlock,
+			currentBlock,
+		)
+	}
+}
+
+type failingBlockCounterChain struct{}
+
+func (fbcc *failingBlockCounterChain) BlockCounter() (chain.BlockCounter, error) {
+	return nil, fmt.Errorf("block counter error")
+}
+
+func TestGetCurrentBlock_BlockCounterError(t *testing.T) {
+	_, err := getCurrentBlock(&failingBlockCounterChain{})
+
+	expectedErr := fmt.Errorf(
+		"failed to get block counter: [block counter error]",
+	)
+	if !reflect.DeepEqual(expectedErr, err) {
+		t.Errorf(
+			"unexpected error\nexpected: [%v]\nactual:   [%v]",
+			expectedErr,
+			err,
+		)
+	}
+}
@@ -287,7 +287,7 @@ func TestCoordinationExecutor_Coordinate(t *testing.T) {
 	operator3 := generateOperator(3)
 
 	coordinatedWallet := wallet{
-		publicKey: unmarshalPublicKey(publicKeyHex),
+		publicKey: unmarshalTestPublicKey(t, publicKeyHex),
 		signingGroupOperators: []chain.Address{
 			operator2.address,
 			operator3.address,
@@ -464,7 +464,7 @@ func TestCoordinationExecutor_GetSeed(t *testing.T) {
 
 	coordinatedWallet := wallet{
 		// Set only relevant fields.
-		publicKey: unmarshalPublicKey(publicKeyHex),
+		publicKey: unmarshalTestPublicKey(t, publicKeyHex),
 	}
 
 	executor := &coordinationExecutor{
@@ -689,7 +689,7 @@ func TestCoordinationExecutor_ExecuteLeaderRoutine(t *testing.T) {
 
 	coordinatedWallet := wallet{
 		// Set only relevant fields.
-		publicKey: unmarshalPublicKey(publicKeyHex),
+		publicKey: unmarshalTestPublicKey(t, publicKeyHex),
 	}
 
 	// Deliberately use an unsorted list of members indexes to make sure the
@@ -957,7 +957,7 @@ func TestCoordinationExecutor_ExecuteFollowerRoutine(t *testing.T) {
 	follower2 := generateOperator()
 
 	coordinatedWallet := wallet{
-		publicKey: unmarshalPublicKey(publicKeyHex),
+		publicKey: unmarshalTestPublicKey(t, publicKeyHex),
 		signingGroupOperators: []chain.Address{
 			follower1.address,
 			follower2.address,
@@ -1197,7 +1197,7 @@ func TestCoordinationExecutor_ExecuteFollowerRoutine_WithIdleLeader(t *testing.T
 	follower2 := generateOperator()
 
 	coordinatedWallet := wallet{
-		publicKey: unmarshalPublicKey(publicKeyHex),
+		publicKey: unmarshalTestPublicKey(t, publicKeyHex),
 		signingGroupOperators: []chain.Address{
 			follower1,
 			follower2,
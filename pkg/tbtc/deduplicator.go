@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"math/big"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/keep-network/keep-common/pkg/cache"
@@ -19,6 +20,15 @@ const (
 	// WalletClosedCachePeriod is the time period the cache maintains the ID of
 	// a closed wallet.
 	WalletClosedCachePeriod = 7 * 24 * time.Hour
+	// DKGTimedOutCachePeriod is the time period the cache maintains
+	// the DKG seed and block of a DKG timeout.
+	DKGTimedOutCachePeriod = 7 * 24 * time.Hour
+	// FraudChallengeSubmittedCachePeriod is the time period the cache
+	// maintains the wallet and sighash of a submitted fraud challenge.
+	FraudChallengeSubmittedCachePeriod = 7 * 24 * time.Hour
+	// MovingFundsTimedOutCachePeriod is the time period the cache maintains
+	// the moving funds timed out for the given wallet at the given block.
+	MovingFundsTimedOutCachePeriod = 7 * 24 * time.Hour
 )
 
 // deduplicator decides whether the given event should be handled by the
@@ -35,17 +45,35 @@ const (
 // - DKG started
 // - DKG result submitted
 // - Wallet closed
+// - DKG timed out
+// - Fraud challenge submitted
+// - Moving funds timed out
 type deduplicator struct {
-	dkgSeedCache       *cache.TimeCache
-	dkgResultHashCache *cache.TimeCache
-	walletClosedCache  *cache.TimeCache
+	dkgSeedCache             *cache.TimeCache
+	dkgResultHashCache       *cache.TimeCache
+	walletClosedCache        *cache.TimeCache
+	dkgTimedOutCache         *cache.TimeCache
+	fraudChallengeCache      *cache.TimeCache
+	movingFundsTimedOutCache *cache.TimeCache
+
+	// dkgSeedGenerationsMutex guards dkgSeedGenerations.
+	dkgSeedGenerationsMutex sync.Mutex
+	// dkgSeedGenerations holds the number of times the given DKG seed was
+	// cleared from the dkgSeedCache, by the seed's hexadecimal representation.
+	// The TimeCache does not support removing items so, a cleared seed is
+	// cached under a key carrying the generation instead.
+	dkgSeedGenerations map[string]uint
 }
 
 func newDeduplicator() *deduplicator {
 	return &deduplicator{
-		dkgSeedCache:       cache.NewTimeCache(DKGSeedCachePeriod),
-		dkgResultHashCache: cache.NewTimeCache(DKGResultHashCachePeriod),
-		walletClosedCache:  cache.NewTimeCache(WalletClosedCachePeriod),
+		dkgSeedCache:             cache.NewTimeCache(DKGSeedCachePeriod),
+		dkgResultHashCache:       cache.NewTimeCache(DKGResultHashCachePeriod),
+		walletClosedCache:        cache.NewTimeCache(WalletClosedCachePeriod),
+		dkgTimedOutCache:         cache.NewTimeCache(DKGTimedOutCachePeriod),
+		fraudChallengeCache:      cache.NewTimeCache(FraudChallengeSubmittedCachePeriod),
+		movingFundsTimedOutCache: cache.NewTimeCache(MovingFundsTimedOutCachePeriod),
+		dkgSeedGenerations:       make(map[string]uint),
 	}
 }
 
@@ -57,8 +85,7 @@ func (d *deduplicator) notifyDKGStarted(
 ) bool {
 	d.dkgSeedCache.Sweep()
 
-	// The cache key is the hexadecimal representation of the seed.
-	cacheKey := newDKGSeed.Text(16)
+	cacheKey := d.dkgSeedCacheKey(newDKGSeed)
 	// If the key is not in the cache, that means the seed was not handled
 	// yet and the client should proceed with the execution.
 	if !d.dkgSeedCache.Has(cacheKey) {
@@ -116,3 +143,101 @@ func (d *deduplicator) notifyWalletClosed(
 	// proceed with the execution.
 	return false
 }
+
+// notifyMovingFundsTimedOut notifies the client wants to handle the moving
+// funds timeout of the given wallet upon receiving an event. It returns
+// boolean indicating whether the client should proceed with the execution or
+// ignore the event as a duplicate.
+func (d *deduplicator) notifyMovingFundsTimedOut(
+	walletPublicKeyHash [20]byte,
+	blockNumber uint64,
+) bool {
+	d.movingFundsTimedOutCache.Sweep()
+
+	// The cache key is the hexadecimal representation of the wallet public
+	// key hash concatenated with the block number of the event.
+	cacheKey := hex.EncodeToString(walletPublicKeyHash[:]) +
+		strconv.FormatUint(blockNumber, 10)
+
+	// If the key is not in the cache, that means the moving funds timeout was
+	// not handled yet and the client should proceed with the execution.
+	if !d.movingFundsTimedOutCache.Has(cacheKey) {
+		d.movingFundsTimedOutCache.Add(cacheKey)
+		return true
+	}
+
+	// Otherwise, the moving funds timeout is a duplicate and the client
+	// should not proceed with the execution.
+	return false
+}
+
+// notifyFraudChallengeSubmitted notifies the client wants to handle a fraud
+// challenge submitted against the given wallet upon receiving an event. It
+// returns boolean indicating whether the client should proceed with the
+// execution or ignore the event as a duplicate.
+func (d *deduplicator) notifyFraudChallengeSubmitted(
+	walletPublicKeyHash [20]byte,
+	sighash [32]byte,
+) bool {
+	d.fraudChallengeCache.Sweep()
+
+	// The cache key is the hexadecimal representation of the wallet public
+	// key hash concatenated with the challenged sighash.
+	cacheKey := hex.EncodeToString(walletPublicKeyHash[:]) +
+		hex.EncodeToString(sighash[:])
+
+	// If the key is not in the cache, that means the fraud challenge was not
+	// handled yet and the client should proceed with the execution.
+	if !d.fraudChallengeCache.Has(cacheKey) {
+		d.fraudChallengeCache.Add(cacheKey)
+		return true
+	}
+
+	// Otherwise, the fraud challenge is a duplicate and the client should not
+	// proceed with the execution.
+	return false
+}
+
+// notifyDKGTimedOut notifies the client wants to handle the DKG timeout upon
+// receiving an event. It returns boolean indicating whether the client should
+// proceed with the execution or ignore the event as a duplicate. If the event
+// is not a duplicate, the DKG seed is cleared so a DKG restarted with the same
+// seed is not considered a duplicate by notifyDKGStarted.
+func (d *deduplicator) notifyDKGTimedOut(
+	dkgSeed *big.Int,
+	timeoutBlock uint64,
+) bool {
+	d.dkgTimedOutCache.Sweep()
+
+	cacheKey := dkgSeed.Text(16) + strconv.Itoa(int(timeoutBlock))
+
+	// If the key is in the cache, that means the timeout was already handled
+	// and the client should not proceed with the execution.
+	if d.dkgTimedOutCache.Has(cacheKey) {
+		return false
+	}
+
+	d.dkgTimedOutCache.Add(cacheKey)
+
+	d.dkgSeedGenerationsMutex.Lock()
+	d.dkgSeedGenerations[dkgSeed.Text(16)]++
+	d.dkgSeedGenerationsMutex.Unlock()
+
+	return true
+}
+
+// dkgSeedCacheKey returns the dkgSeedCache key of the given DKG seed. The key
+// is the hexadecimal representation of the seed, suffixed with the seed
+// generation if the seed was ever cleared.
+func (d *deduplicator) dkgSeedCacheKey(dkgSeed *big.Int) string {
+	seedKey := dkgSeed.Text(16)
+
+	d.dkgSeedGenerationsMutex.Lock()
+	defer d.dkgSeedGenerationsMutex.Unlock()
+
+	if generation := d.dkgSeedGenerations[seedKey]; generation > 0 {
+		return seedKey + "-" + strconv.Itoa(int(generation))
+	}
+
+	return seedKey
+}
@@ -10,9 +10,12 @@ import (
 )
 
 const (
-	testDKGSeedCachePeriod       = 1 * time.Second
-	testDKGResultHashCachePeriod = 1 * time.Second
-	testWalletClosedCachePeriod  = 1 * time.Second
+	testDKGSeedCachePeriod             = 1 * time.Second
+	testDKGResultHashCachePeriod       = 1 * time.Second
+	testWalletClosedCachePeriod        = 1 * time.Second
+	testDKGTimedOutCachePeriod         = 1 * time.Second
+	testFraudChallengeCachePeriod      = 1 * time.Second
+	testMovingFundsTimedOutCachePeriod = 1 * time.Second
 )
 
 func TestNotifyDKGStarted(t *testing.T) {
@@ -151,3 +154,172 @@ func TestNotifyWalletClosed(t *testing.T) {
 		t.Fatal("should be allowed to process")
 	}
 }
+
+func TestNotifyFraudChallengeSubmitted(t *testing.T) {
+	deduplicator := deduplicator{
+		fraudChallengeCache: cache.NewTimeCache(
+			testFraudChallengeCachePeriod,
+		),
+	}
+
+	walletPublicKeyHash1 := [20]byte{0x01}
+	walletPublicKeyHash2 := [20]byte{0x02}
+	sighash1 := [32]byte{0x03}
+	sighash2 := [32]byte{0x04}
+
+	// Add the original parameters.
+	canProcess := deduplicator.notifyFraudChallengeSubmitted(
+		walletPublicKeyHash1,
+		sighash1,
+	)
+	if !canProcess {
+		t.Fatal("should be allowed to process")
+	}
+
+	// Add with different sighash.
+	canProcess = deduplicator.notifyFraudChallengeSubmitted(
+		walletPublicKeyHash1,
+		sighash2,
+	)
+	if !canProcess {
+		t.Fatal("should be allowed to process")
+	}
+
+	// Add with different wallet public key hash.
+	canProcess = deduplicator.notifyFraudChallengeSubmitted(
+		walletPublicKeyHash2,
+		sighash1,
+	)
+	if !canProcess {
+		t.Fatal("should be allowed to process")
+	}
+
+	// Add the original parameters before caching period elapses.
+	canProcess = deduplicator.notifyFraudChallengeSubmitted(
+		walletPublicKeyHash1,
+		sighash1,
+	)
+	if canProcess {
+		t.Fatal("should not be allowed to process")
+	}
+
+	// Wait until caching period elapses.
+	time.Sleep(testFraudChallengeCachePeriod)
+
+	// Add the original parameters again.
+	canProcess = deduplicator.notifyFraudChallengeSubmitted(
+		walletPublicKeyHash1,
+		sighash1,
+	)
+	if !canProcess {
+		t.Fatal("should be allowed to process")
+	}
+}
+
+func TestNotifyMovingFundsTimedOut(t *testing.T) {
+	deduplicator := deduplicator{
+		movingFundsTimedOutCache: cache.NewTimeCache(
+			testMovingFundsTimedOutCachePeriod,
+		),
+	}
+
+	walletPublicKeyHash1 := [20]byte{0x01}
+	walletPublicKeyHash2 := [20]byte{0x02}
+
+	// Add the original parameters.
+	canProcess := deduplicator.notifyMovingFundsTimedOut(walletPublicKeyHash1, 100)
+	if !canProcess {
+		t.Fatal("should be allowed to process")
+	}
+
+	// Add with different block number.
+	canProcess = deduplicator.notifyMovingFundsTimedOut(walletPublicKeyHash1, 101)
+	if !canProcess {
+		t.Fatal("should be allowed to process")
+	}
+
+	// Add with different wallet public key hash.
+	canProcess = deduplicator.notifyMovingFundsTimedOut(walletPublicKeyHash2, 100)
+	if !canProcess {
+		t.Fatal("should be allowed to process")
+	}
+
+	// Add the original parameters before caching period elapses.
+	canProcess = deduplicator.notifyMovingFundsTimedOut(walletPublicKeyHash1, 100)
+	if canProcess {
+		t.Fatal("should not be allowed to process")
+	}
+
+	// Wait until caching period elapses.
+	time.Sleep(testMovingFundsTimedOutCachePeriod)
+
+	// Add the original parameters again.
+	canProcess = deduplicator.notifyMovingFundsTimedOut(walletPublicKeyHash1, 100)
+	if !canProcess {
+		t.Fatal("should be allowed to process")
+	}
+}
+
+func TestNotifyDKGTimedOut(t *testing.T) {
+	deduplicator := deduplicator{
+		dkgSeedCache:       cache.NewTimeCache(testDKGSeedCachePeriod),
+		dkgTimedOutCache:   cache.NewTimeCache(testDKGTimedOutCachePeriod),
+		dkgSeedGenerations: make(map[string]uint),
+	}
+
+	seed1 := big.NewInt(100)
+	seed2 := big.NewInt(200)
+
+	// Start DKGs with both seeds.
+	if !deduplicator.notifyDKGStarted(seed1) {
+		t.Fatal("should be allowed to join DKG")
+	}
+	if !deduplicator.notifyDKGStarted(seed2) {
+		t.Fatal("should be allowed to join DKG")
+	}
+
+	// Time out the DKG with the first seed.
+	canHandleTimeout := deduplicator.notifyDKGTimedOut(seed1, 1000)
+	if !canHandleTimeout {
+		t.Fatal("should be allowed to handle DKG timeout")
+	}
+
+	// Handle the same timeout again before caching period elapses.
+	canHandleTimeout = deduplicator.notifyDKGTimedOut(seed1, 1000)
+	if canHandleTimeout {
+		t.Fatal("should not be allowed to handle DKG timeout")
+	}
+
+	// The first seed was cleared so, the restarted DKG must not be filtered
+	// out. The second seed must remain cached.
+	if !deduplicator.notifyDKGStarted(seed1) {
+		t.Fatal("should be allowed to join restarted DKG")
+	}
+	if deduplicator.notifyDKGStarted(seed2) {
+		t.Fatal("should not be allowed to join DKG")
+	}
+
+	// The restarted DKG is deduplicated as any other DKG.
+	if deduplicator.notifyDKGStarted(seed1) {
+		t.Fatal("should not be allowed to join restarted DKG")
+	}
+
+	// Time out the restarted DKG at another block.
+	canHandleTimeout = deduplicator.notifyDKGTimedOut(seed1, 2000)
+	if !canHandleTimeout {
+		t.Fatal("should be allowed to handle DKG timeout")
+	}
+
+	if !deduplicator.notifyDKGStarted(seed1) {
+		t.Fatal("should be allowed to join restarted DKG")
+	}
+
+	// Wait until caching period elapses.
+	time.Sleep(testDKGTimedOutCachePeriod)
+
+	// Handle the first timeout again.
+	canHandleTimeout = deduplicator.notifyDKGTimedOut(seed1, 1000)
+	if !canHandleTimeout {
+		t.Fatal("should be allowed to handle DKG timeout")
+	}
+}
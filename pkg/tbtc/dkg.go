@@ -7,6 +7,8 @@ import (
 	"golang.org/x/exp/maps"
 	"math/big"
 	"sort"
+	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -40,10 +42,13 @@ const (
 	// submission. Once the period elapses, the DKG state is checked to confirm
 	// the challenge was accepted successfully.
 	dkgResultChallengeConfirmationBlocks = 20
-	// dkgAttemptsLimit determines the maximum number of attempts to execute
-	// the DKG protocol. If the limit is reached, the protocol execution is
-	// aborted.
-	dkgAttemptsLimit = 1
+	// beaconBusyBlocks determines the block length of the period following
+	// a beacon relay entry request during which the beacon is considered
+	// busy generating the entry. DKG is not started during that period.
+	beaconBusyBlocks = 20
+	// dkgAttemptEventsBuffer is the size of the buffer of the channel
+	// receiving events of DKG attempts made by the node.
+	dkgAttemptEventsBuffer = 32
 )
 
 // dkgExecutor is a component responsible for the full execution of ECDSA
@@ -63,7 +68,46 @@ type dkgExecutor struct {
 	// waitForBlockFn is a function used to wait for the given block.
 	waitForBlockFn waitForBlockFn
 
+	// goroutineTracker tracks goroutines executing DKG for the node's
+	// members.
+	goroutineTracker *goroutineTracker
+
 	tecdsaExecutor *dkg.Executor
+
+	// maxGasPrice is the maximum gas price, in wei, used for DKG result
+	// submissions.
+	maxGasPrice *big.Int
+
+	// attemptsLimit determines the maximum number of attempts to execute
+	// the DKG protocol. If the limit is reached, the protocol execution is
+	// aborted. Zero means no limit.
+	attemptsLimit uint
+
+	// announcerTimeout determines the maximum time the readiness
+	// announcement of a single DKG attempt waits for other group members.
+	// Zero means the announcement is bounded only by the announcement end
+	// block.
+	announcerTimeout time.Duration
+
+	// retryLoopsMutex guards retryLoops and completedRetryAttempts.
+	retryLoopsMutex sync.Mutex
+	// retryLoops holds retry loops of DKGs currently executed by the node,
+	// by DKG seed. If the node controls multiple members of the group, only
+	// the first registered loop is tracked as loops of all members follow
+	// the same attempts schedule.
+	retryLoops map[string]*dkgRetryLoop
+	// completedRetryAttempts is the number of attempts made by retry loops
+	// that are no longer executed.
+	completedRetryAttempts uint
+
+	// attemptEvents receives events of DKG attempts made by tracked retry
+	// loops.
+	attemptEvents chan DKGAttemptEvent
+
+	lastAttemptEventMutex sync.Mutex
+	// lastAttemptEvent is the most recent event received from attemptEvents.
+	// Nil means no DKG attempt was made by the node yet.
+	lastAttemptEvent *DKGAttemptEvent
 }
 
 // newDkgExecutor creates a new instance of dkgExecutor struct. There should
@@ -80,6 +124,7 @@ func newDkgExecutor(
 	workPersistence persistence.BasicHandle,
 	scheduler *generator.Scheduler,
 	waitForBlockFn waitForBlockFn,
+	goroutineTracker *goroutineTracker,
 ) *dkgExecutor {
 	tecdsaExecutor := dkg.NewExecutor(
 		logger,
@@ -92,17 +137,38 @@ func newDkgExecutor(
 		config.KeyGenerationConcurrency,
 	)
 
-	return &dkgExecutor{
-		groupParameters: groupParameters,
-		operatorIDFn:    operatorIDFn,
-		operatorAddress: operatorAddress,
-		chain:           chain,
-		netProvider:     netProvider,
-		walletRegistry:  walletRegistry,
-		protocolLatch:   protocolLatch,
-		tecdsaExecutor:  tecdsaExecutor,
-		waitForBlockFn:  waitForBlockFn,
+	maxGasPriceGwei := uint64(DefaultMaxGasPriceGwei)
+	if config.MaxGasPriceGwei > 0 {
+		maxGasPriceGwei = config.MaxGasPriceGwei
+	}
+	// 1 Gwei is 10^9 wei.
+	maxGasPrice := new(big.Int).Mul(
+		new(big.Int).SetUint64(maxGasPriceGwei),
+		big.NewInt(1e9),
+	)
+
+	executor := &dkgExecutor{
+		groupParameters:  groupParameters,
+		operatorIDFn:     operatorIDFn,
+		operatorAddress:  operatorAddress,
+		chain:            chain,
+		netProvider:      netProvider,
+		walletRegistry:   walletRegistry,
+		protocolLatch:    protocolLatch,
+		tecdsaExecutor:   tecdsaExecutor,
+		waitForBlockFn:   waitForBlockFn,
+		goroutineTracker: goroutineTracker,
+		maxGasPrice:      maxGasPrice,
+		attemptsLimit:    config.MaxDKGAttempts,
+		retryLoops:       make(map[string]*dkgRetryLoop),
+		attemptEvents:    make(chan DKGAttemptEvent, dkgAttemptEventsBuffer),
+
+		announcerTimeout: config.DKGAnnouncerTimeout,
 	}
+
+	go executor.recordAttemptEvents()
+
+	return executor
 }
 
 // preParamsCount returns the current count of the ECDSA DKG pre-parameters.
@@ -110,6 +176,95 @@ func (de *dkgExecutor) preParamsCount() int {
 	return de.tecdsaExecutor.PreParamsCount()
 }
 
+// preParamsAgeHistogram returns the histogram of the age of the ECDSA DKG
+// pre-parameters at the time they were consumed.
+func (de *dkgExecutor) preParamsAgeHistogram() *dkg.DurationHistogram {
+	return de.tecdsaExecutor.PreParamsAgeHistogram()
+}
+
+// phaseDurationHistograms returns histograms of the duration of the ECDSA DKG
+// protocol phases, by phase name.
+func (de *dkgExecutor) phaseDurationHistograms() map[string]*dkg.DurationHistogram {
+	return de.tecdsaExecutor.PhaseDurationHistograms()
+}
+
+// registerRetryLoop starts tracking the given retry loop, unless another loop
+// is already tracked for the same DKG seed. Only the tracked loop reports its
+// attempts events. This function must be called before the loop is started.
+func (de *dkgExecutor) registerRetryLoop(retryLoop *dkgRetryLoop) {
+	de.retryLoopsMutex.Lock()
+	defer de.retryLoopsMutex.Unlock()
+
+	seed := retryLoop.seed.Text(16)
+	if _, ok := de.retryLoops[seed]; !ok {
+		de.retryLoops[seed] = retryLoop
+		retryLoop.events = de.attemptEvents
+	}
+}
+
+// unregisterRetryLoop stops tracking the given retry loop and records the
+// attempts it made.
+func (de *dkgExecutor) unregisterRetryLoop(retryLoop *dkgRetryLoop) {
+	de.retryLoopsMutex.Lock()
+	defer de.retryLoopsMutex.Unlock()
+
+	seed := retryLoop.seed.Text(16)
+	if de.retryLoops[seed] == retryLoop {
+		de.completedRetryAttempts += retryLoop.AttemptCount()
+		delete(de.retryLoops, seed)
+	}
+}
+
+// retryAttempts returns the number of attempts made so far by DKGs currently
+// executed by the node, by DKG seed.
+func (de *dkgExecutor) retryAttempts() map[string]uint {
+	de.retryLoopsMutex.Lock()
+	defer de.retryLoopsMutex.Unlock()
+
+	attempts := make(map[string]uint, len(de.retryLoops))
+	for seed, retryLoop := range de.retryLoops {
+		attempts[seed] = retryLoop.AttemptCount()
+	}
+
+	return attempts
+}
+
+// recordAttemptEvents records events of DKG attempts received by the
+// executor so the last one can be reported. It blocks until the events
+// channel is closed.
+func (de *dkgExecutor) recordAttemptEvents() {
+	for event := range de.attemptEvents {
+		event := event
+
+		de.lastAttemptEventMutex.Lock()
+		de.lastAttemptEvent = &event
+		de.lastAttemptEventMutex.Unlock()
+	}
+}
+
+// lastAttempt returns the most recent event of a DKG attempt made by the
+// node. Nil is returned if the node has not made any DKG attempt yet.
+func (de *dkgExecutor) lastAttempt() *DKGAttemptEvent {
+	de.lastAttemptEventMutex.Lock()
+	defer de.lastAttemptEventMutex.Unlock()
+
+	return de.lastAttemptEvent
+}
+
+// retryAttemptsTotal returns the total number of DKG attempts made by the
+// node since it started.
+func (de *dkgExecutor) retryAttemptsTotal() uint {
+	de.retryLoopsMutex.Lock()
+	defer de.retryLoopsMutex.Unlock()
+
+	total := de.completedRetryAttempts
+	for _, retryLoop := range de.retryLoops {
+		total += retryLoop.AttemptCount()
+	}
+
+	return total
+}
+
 // executeDkgIfEligible is the main function of dkgExecutor. It performs the
 // full execution of ECDSA Distributed Key Generation: determining members
 // selected to the signing group, executing off-chain protocol, and publishing
@@ -242,6 +397,19 @@ func (de *dkgExecutor) setupBroadcastChannel(
 	return broadcastChannel, nil
 }
 
+// dkgSessionLogger returns a logger pre-populated with the standard fields
+// identifying the DKG session of the given member. Loggers of specific DKG
+// attempts should be derived from this logger.
+func (de *dkgExecutor) dkgSessionLogger(
+	seed *big.Int,
+	memberIndex uint8,
+) *zap.SugaredLogger {
+	return logger.With(
+		zap.String("seed", fmt.Sprintf("0x%x", seed)),
+		zap.Uint8("memberIndex", memberIndex),
+	)
+}
+
 // generateSigningGroup executes off-chain protocol for each member controlled
 // by the current operator and upon successful execution of the protocol
 // publishes the result to the chain. The execution can be delayed by an
@@ -282,7 +450,9 @@ func (de *dkgExecutor) generateSigningGroup(
 		// Capture the member index for the goroutine.
 		memberIndex := index
 
-		go func() {
+		de.goroutineTracker.launch("dkg_member", func() {
+			memberLogger := de.dkgSessionLogger(seed, memberIndex)
+
 			de.protocolLatch.Lock()
 			defer de.protocolLatch.Unlock()
 
@@ -300,7 +470,7 @@ func (de *dkgExecutor) generateSigningGroup(
 				func(event *DKGResultSubmittedEvent) {
 					defer cancelCtx()
 
-					dkgLogger.Infof(
+					memberLogger.Infof(
 						"[member:%v] DKG result with group public "+
 							"key [0x%x] and result hash [0x%x] submitted "+
 							"at block [%v] by member [%v]",
@@ -317,35 +487,39 @@ func (de *dkgExecutor) generateSigningGroup(
 				fmt.Sprintf("%v-%v", ProtocolName, "dkg"),
 				broadcastChannel,
 				membershipValidator,
+				announcer.WithTimeout(de.announcerTimeout),
 			)
 
 			retryLoop := newDkgRetryLoop(
-				dkgLogger,
+				memberLogger,
 				seed,
 				startBlock+delayBlocks,
 				memberIndex,
 				groupSelectionResult.OperatorsAddresses,
 				de.groupParameters,
 				announcer,
-				dkgAttemptsLimit,
+				de.attemptsLimit,
 			)
 
+			de.registerRetryLoop(retryLoop)
+			defer de.unregisterRetryLoop(retryLoop)
+
 			result, err := retryLoop.start(
 				ctx,
 				de.waitForBlockFn,
 				func(attempt *dkgAttemptParams) (*dkg.Result, error) {
-					dkgAttemptLogger := dkgLogger.With(
+					dkgAttemptLogger := memberLogger.With(
 						zap.Uint("attempt", attempt.number),
 						zap.Uint64("attemptStartBlock", attempt.startBlock),
 						zap.Uint64("attemptTimeoutBlock", attempt.timeoutBlock),
 					)
 
 					dkgAttemptLogger.Infof(
-						"[member:%v] scheduled dkg attempt "+
-							"with [%v] group members (excluded: [%v])",
+						"[member:%v] scheduled dkg %s; [%v] group members "+
+							"will participate",
 						memberIndex,
+						attempt,
 						de.groupParameters.GroupSize-len(attempt.excludedMembersIndexes),
-						attempt.excludedMembersIndexes,
 					)
 
 					// Set up the attempt timeout signal.
@@ -389,7 +563,7 @@ func (de *dkgExecutor) generateSigningGroup(
 			)
 			if err != nil {
 				if errors.Is(err, context.Canceled) {
-					dkgLogger.Infof(
+					memberLogger.Infof(
 						"[member:%v] DKG is no longer awaiting the result; "+
 							"aborting DKG protocol execution",
 						memberIndex,
@@ -397,7 +571,7 @@ func (de *dkgExecutor) generateSigningGroup(
 					return
 				}
 
-				dkgLogger.Errorf(
+				memberLogger.Errorf(
 					"[member:%v] failed to execute DKG: [%v]",
 					memberIndex,
 					err,
@@ -411,18 +585,28 @@ func (de *dkgExecutor) generateSigningGroup(
 				groupSelectionResult.OperatorsAddresses,
 			)
 			if err != nil {
-				dkgLogger.Errorf(
+				memberLogger.Errorf(
 					"[member:%v] failed to register signing group member: [%v]",
 					memberIndex,
 					err,
 				)
 			}
 
-			dkgLogger.Infof("registered %s", signer)
+			memberLogger.Infof("registered %s", signer)
+
+			if !result.IsComplete() {
+				memberLogger.Warnf(
+					"[member:%v] publishing partial DKG result; only [%v] "+
+						"out of [%v] members are operating",
+					memberIndex,
+					len(result.Group.OperatingMemberIndexes()),
+					result.Group.GroupSize(),
+				)
+			}
 
 			err = de.publishDkgResult(
 				ctx,
-				dkgLogger,
+				memberLogger,
 				seed,
 				memberIndex,
 				broadcastChannel,
@@ -433,7 +617,7 @@ func (de *dkgExecutor) generateSigningGroup(
 			)
 			if err != nil {
 				if errors.Is(err, context.Canceled) {
-					dkgLogger.Infof(
+					memberLogger.Infof(
 						"[member:%v] DKG is no longer awaiting the result; "+
 							"aborting DKG result publication",
 						memberIndex,
@@ -441,14 +625,14 @@ func (de *dkgExecutor) generateSigningGroup(
 					return
 				}
 
-				dkgLogger.Errorf(
+				memberLogger.Errorf(
 					"[member:%v] DKG result publication failed [%v]",
 					memberIndex,
 					err,
 				)
 				return
 			}
-		}()
+		})
 	}
 }
 
@@ -488,12 +672,15 @@ func (de *dkgExecutor) registerSigner(
 		)
 	}
 
-	signer := newSigner(
+	signer, err := newSigner(
 		result.PrivateKeyShare.PublicKey(),
 		finalSigningGroupOperators,
 		finalSigningGroupMemberIndex,
 		result.PrivateKeyShare,
 	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signer: [%v]", err)
+	}
 
 	err = de.walletRegistry.registerSigner(signer)
 	if err != nil {
@@ -532,6 +719,8 @@ func (de *dkgExecutor) publishDkgResult(
 			de.chain,
 			de.groupParameters,
 			groupSelectionResult,
+			de.chain,
+			de.maxGasPrice,
 			de.waitForBlockFn,
 		),
 		dkgResult,
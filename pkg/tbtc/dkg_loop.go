@@ -4,9 +4,14 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"github.com/keep-network/keep-core/pkg/protocol/announcer"
 	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/ipfs/go-log/v2"
 	"github.com/keep-network/keep-core/pkg/chain"
@@ -31,6 +36,10 @@ const (
 	// dkgAttemptCoolDownBlocks determines the duration of the cool down
 	// period that is preserved between subsequent DKG attempts.
 	dkgAttemptCoolDownBlocks = 5
+	// dkgAnnouncerMinimumTimeout is the minimum accepted timeout of the
+	// announcement phase of a DKG attempt. It is the duration of the
+	// announcement phase assuming 12 seconds per block.
+	dkgAnnouncerMinimumTimeout = dkgAttemptAnnouncementActiveBlocks * 12 * time.Second
 )
 
 // dkgAttemptMaximumBlocks returns the maximum block duration of a single
@@ -42,6 +51,10 @@ func dkgAttemptMaximumBlocks() uint {
 		dkgAttemptCoolDownBlocks
 }
 
+// ErrMaxAttemptsExceeded is returned by the DKG retry loop when the limit
+// of attempts is reached without producing the DKG result.
+var ErrMaxAttemptsExceeded = errors.New("reached the limit of attempts")
+
 // dkgAnnouncer represents a component responsible for exchanging readiness
 // announcements for the given DKG attempt for the given seed.
 type dkgAnnouncer interface {
@@ -52,6 +65,30 @@ type dkgAnnouncer interface {
 	) ([]group.MemberIndex, error)
 }
 
+// DKGAttemptOutcome is the stage of a DKG attempt reported by
+// a DKGAttemptEvent.
+type DKGAttemptOutcome string
+
+const (
+	// DKGAttemptStarted is reported when the retry loop begins an attempt.
+	DKGAttemptStarted DKGAttemptOutcome = "started"
+	// DKGAttemptSucceeded is reported when an attempt produced the DKG
+	// result.
+	DKGAttemptSucceeded DKGAttemptOutcome = "succeeded"
+	// DKGAttemptFailed is reported when an attempt ended without producing
+	// the DKG result, for any reason. This includes attempts skipped by
+	// the member and attempts interrupted by the retry loop termination.
+	DKGAttemptFailed DKGAttemptOutcome = "failed"
+)
+
+// DKGAttemptEvent represents a change of the state of a DKG attempt
+// performed by the DKG retry loop.
+type DKGAttemptEvent struct {
+	Attempt   uint              `json:"attempt"`
+	Outcome   DKGAttemptOutcome `json:"outcome"`
+	Timestamp time.Time         `json:"timestamp"`
+}
+
 // dkgRetryLoop is a struct that encapsulates the DKG retry logic.
 type dkgRetryLoop struct {
 	logger log.StandardLogger
@@ -67,14 +104,22 @@ type dkgRetryLoop struct {
 
 	announcer dkgAnnouncer
 
-	attemptCounter    uint
-	attemptStartBlock uint64
+	// attemptCounterMutex guards writes of attemptCounter and its reads
+	// performed outside the retry loop goroutine.
+	attemptCounterMutex sync.RWMutex
+	attemptCounter      uint
+	attemptStartBlock   uint64
 	// attemptSeed is a 8-byte seed obtained from the original seed.
 	// Used for the random operator selection. It never changes.
 	attemptSeed        int64
 	attemptDelayBlocks uint64
 
 	attemptsLimit uint
+
+	// events is an optional channel receiving an event on each attempt
+	// start, success, and failure. Events are dropped if the channel is not
+	// ready to receive them so that a slow consumer never blocks the loop.
+	events chan<- DKGAttemptEvent
 }
 
 func newDkgRetryLoop(
@@ -117,6 +162,24 @@ type dkgAttemptParams struct {
 	excludedMembersIndexes []group.MemberIndex
 }
 
+// String returns a human-readable representation of the DKG attempt
+// parameters, suitable for logging.
+func (dap *dkgAttemptParams) String() string {
+	excludedMembersIndexes := make([]string, len(dap.excludedMembersIndexes))
+	for i, memberIndex := range dap.excludedMembersIndexes {
+		excludedMembersIndexes[i] = strconv.Itoa(int(memberIndex))
+	}
+
+	return fmt.Sprintf(
+		"attempt [%v] with start block [%v], timeout block [%v] "+
+			"and excluded members [%v]",
+		dap.number,
+		dap.startBlock,
+		dap.timeoutBlock,
+		strings.Join(excludedMembersIndexes, ", "),
+	)
+}
+
 // dkgAttemptFn represents a function performing a DKG attempt.
 type dkgAttemptFn func(*dkgAttemptParams) (*dkg.Result, error)
 
@@ -129,15 +192,20 @@ func (drl *dkgRetryLoop) start(
 	dkgAttemptFn dkgAttemptFn,
 ) (*dkg.Result, error) {
 	for {
-		drl.attemptCounter++
-
-		if drl.attemptsLimit != 0 && drl.attemptCounter > drl.attemptsLimit {
+		if drl.attemptsLimit != 0 && drl.attemptCounter >= drl.attemptsLimit {
 			return nil, fmt.Errorf(
-				"reached the limit of attempts [%v]",
+				"%w [%v]",
+				ErrMaxAttemptsExceeded,
 				drl.attemptsLimit,
 			)
 		}
 
+		drl.attemptCounterMutex.Lock()
+		drl.attemptCounter++
+		drl.attemptCounterMutex.Unlock()
+
+		drl.emitEvent(DKGAttemptStarted)
+
 		// In order to start attempts >1 in the right place, we need to
 		// determine how many blocks were taken by previous attempts. We assume
 		// the worst case that each attempt failed at the end of the DKG
@@ -161,6 +229,7 @@ func (drl *dkgRetryLoop) start(
 		announcementStartBlock := drl.attemptStartBlock + dkgAttemptAnnouncementDelayBlocks
 		err := waitForBlockFn(ctx, announcementStartBlock)
 		if err != nil {
+			drl.emitEvent(DKGAttemptFailed)
 			return nil, fmt.Errorf(
 				"failed waiting for announcement start block [%v] "+
 					"for attempt [%v]: [%v]",
@@ -207,6 +276,7 @@ func (drl *dkgRetryLoop) start(
 				drl.attemptCounter,
 				err,
 			)
+			drl.emitEvent(DKGAttemptFailed)
 			continue
 		}
 
@@ -217,6 +287,7 @@ func (drl *dkgRetryLoop) start(
 
 		// Check the loop stop signal.
 		if ctx.Err() != nil {
+			drl.emitEvent(DKGAttemptFailed)
 			return nil, ctx.Err()
 		}
 
@@ -241,6 +312,7 @@ func (drl *dkgRetryLoop) start(
 				len(readyMembersIndexes),
 				unreadyMembersIndexes,
 			)
+			drl.emitEvent(DKGAttemptFailed)
 			continue
 		}
 
@@ -248,6 +320,7 @@ func (drl *dkgRetryLoop) start(
 			readyMembersIndexes,
 		)
 		if err != nil {
+			drl.emitEvent(DKGAttemptFailed)
 			return nil, fmt.Errorf(
 				"cannot select members for attempt [%v]: [%w]",
 				drl.attemptCounter,
@@ -281,13 +354,52 @@ func (drl *dkgRetryLoop) start(
 		}
 
 		if attemptSkipped || attemptErr != nil {
+			drl.emitEvent(DKGAttemptFailed)
 			continue
 		}
 
+		drl.emitEvent(DKGAttemptSucceeded)
+
 		return result, nil
 	}
 }
 
+// emitEvent sends an event with the given outcome of the current attempt
+// to the events channel, if set. The event is dropped if the channel is not
+// ready to receive it.
+func (drl *dkgRetryLoop) emitEvent(outcome DKGAttemptOutcome) {
+	if drl.events == nil {
+		return
+	}
+
+	event := DKGAttemptEvent{
+		Attempt:   drl.attemptCounter,
+		Outcome:   outcome,
+		Timestamp: time.Now(),
+	}
+
+	select {
+	case drl.events <- event:
+	default:
+		drl.logger.Warnf(
+			"[member:%v] dropped [%v] event for attempt [%v]",
+			drl.memberIndex,
+			outcome,
+			drl.attemptCounter,
+		)
+	}
+}
+
+// AttemptCount returns the number of attempts started by the retry loop so
+// far, including the currently running one. It is safe to call concurrently
+// with start.
+func (drl *dkgRetryLoop) AttemptCount() uint {
+	drl.attemptCounterMutex.RLock()
+	defer drl.attemptCounterMutex.RUnlock()
+
+	return drl.attemptCounter
+}
+
 // performMembersSelection runs the member selection process whose result
 // is a list of members' indexes that should be excluded by the client
 // for the given DKG attempt.
@@ -2,6 +2,7 @@ package tbtc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -10,7 +11,11 @@ import (
 
 	"github.com/keep-network/keep-core/internal/testutils"
 	"github.com/keep-network/keep-core/pkg/chain"
+	"github.com/keep-network/keep-core/pkg/chain/local_v1"
 	"github.com/keep-network/keep-core/pkg/internal/tecdsatest"
+	"github.com/keep-network/keep-core/pkg/net/local"
+	"github.com/keep-network/keep-core/pkg/operator"
+	"github.com/keep-network/keep-core/pkg/protocol/announcer"
 	"github.com/keep-network/keep-core/pkg/protocol/group"
 	"github.com/keep-network/keep-core/pkg/tecdsa"
 	"github.com/keep-network/keep-core/pkg/tecdsa/dkg"
@@ -259,7 +264,7 @@ func TestDkgRetryLoop(t *testing.T) {
 				return testResult, nil
 			},
 			attemptsLimit:       1,
-			expectedErr:         fmt.Errorf("reached the limit of attempts [1]"),
+			expectedErr:         fmt.Errorf("%w [%v]", ErrMaxAttemptsExceeded, 1),
 			expectedResult:      nil,
 			expectedLastAttempt: nil,
 		},
@@ -329,6 +334,15 @@ func TestDkgRetryLoop(t *testing.T) {
 				)
 			}
 
+			if test.attemptsLimit != 0 &&
+				retryLoop.AttemptCount() > test.attemptsLimit {
+				t.Errorf(
+					"attempt count [%v] exceeds the limit [%v]",
+					retryLoop.AttemptCount(),
+					test.attemptsLimit,
+				)
+			}
+
 			if test.expectedLastAttempt != nil {
 				testutils.AssertIntsEqual(
 					t,
@@ -354,6 +368,585 @@ func TestDkgRetryLoop(t *testing.T) {
 	}
 }
 
+func TestDkgRetryLoop_AttemptCount(t *testing.T) {
+	groupParameters := &GroupParameters{
+		GroupSize:       10,
+		GroupQuorum:     8,
+		HonestThreshold: 6,
+	}
+
+	selectedOperators := make(chain.Addresses, 0)
+	membersIndexes := make([]group.MemberIndex, 0)
+	for i := 1; i <= groupParameters.GroupSize; i++ {
+		selectedOperators = append(
+			selectedOperators,
+			chain.Address(fmt.Sprintf("address-%v", i)),
+		)
+		membersIndexes = append(membersIndexes, group.MemberIndex(i))
+	}
+
+	announcer := &mockDkgAnnouncer{
+		outgoingAnnouncements: make(map[string]group.MemberIndex),
+		incomingAnnouncementsFn: func(sessionID string) ([]group.MemberIndex, error) {
+			return membersIndexes, nil
+		},
+	}
+
+	retryLoop := newDkgRetryLoop(
+		&testutils.MockLogger{},
+		big.NewInt(100),
+		200,
+		1,
+		selectedOperators,
+		groupParameters,
+		announcer,
+		0, // no limit
+	)
+
+	testutils.AssertUintsEqual(
+		t,
+		"attempt count before start",
+		0,
+		uint64(retryLoop.AttemptCount()),
+	)
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelCtx()
+
+	successfulAttempt := 3
+	attemptFnInvocations := 0
+
+	_, err := retryLoop.start(
+		ctx,
+		func(ctx context.Context, attemptStartBlock uint64) error {
+			return nil
+		},
+		func(params *dkgAttemptParams) (*dkg.Result, error) {
+			attemptFnInvocations++
+
+			testutils.AssertUintsEqual(
+				t,
+				"attempt count during attempt",
+				uint64(params.number),
+				uint64(retryLoop.AttemptCount()),
+			)
+
+			if attemptFnInvocations < successfulAttempt {
+				return nil, fmt.Errorf("unexpected error")
+			}
+
+			return &dkg.Result{}, nil
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutils.AssertIntsEqual(
+		t,
+		"attempt function invocations",
+		successfulAttempt,
+		attemptFnInvocations,
+	)
+	testutils.AssertUintsEqual(
+		t,
+		"attempt count",
+		uint64(attemptFnInvocations),
+		uint64(retryLoop.AttemptCount()),
+	)
+}
+
+func TestDkgRetryLoop_Events(t *testing.T) {
+	seed := big.NewInt(100)
+
+	groupParameters := &GroupParameters{
+		GroupSize:       10,
+		GroupQuorum:     8,
+		HonestThreshold: 6,
+	}
+
+	selectedOperators := make(chain.Addresses, 0)
+	membersIndexes := make([]group.MemberIndex, 0)
+	for i := 1; i <= groupParameters.GroupSize; i++ {
+		selectedOperators = append(
+			selectedOperators,
+			chain.Address(fmt.Sprintf("address-%v", i)),
+		)
+		membersIndexes = append(membersIndexes, group.MemberIndex(i))
+	}
+
+	announcer := &mockDkgAnnouncer{
+		outgoingAnnouncements: make(map[string]group.MemberIndex),
+		incomingAnnouncementsFn: func(sessionID string) ([]group.MemberIndex, error) {
+			// Force the first attempt's announcement failure.
+			if sessionID == fmt.Sprintf("%v-%v", seed, 1) {
+				return nil, fmt.Errorf("unexpected error")
+			}
+
+			return membersIndexes, nil
+		},
+	}
+
+	retryLoop := newDkgRetryLoop(
+		&testutils.MockLogger{},
+		seed,
+		200,
+		1,
+		selectedOperators,
+		groupParameters,
+		announcer,
+		0, // no limit
+	)
+
+	events := make(chan DKGAttemptEvent, 10)
+	retryLoop.events = events
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelCtx()
+
+	startTime := time.Now()
+
+	_, err := retryLoop.start(
+		ctx,
+		func(ctx context.Context, attemptStartBlock uint64) error {
+			return nil
+		},
+		func(params *dkgAttemptParams) (*dkg.Result, error) {
+			// Fail the second attempt and succeed with the third one.
+			if params.number == 2 {
+				return nil, fmt.Errorf("unexpected error")
+			}
+
+			return &dkg.Result{}, nil
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	close(events)
+
+	type attemptOutcome struct {
+		attempt uint
+		outcome DKGAttemptOutcome
+	}
+
+	expectedOutcomes := []attemptOutcome{
+		{1, DKGAttemptStarted},
+		{1, DKGAttemptFailed},
+		{2, DKGAttemptStarted},
+		{2, DKGAttemptFailed},
+		{3, DKGAttemptStarted},
+		{3, DKGAttemptSucceeded},
+	}
+
+	var actualOutcomes []attemptOutcome
+	lastTimestamp := startTime
+	for event := range events {
+		actualOutcomes = append(
+			actualOutcomes,
+			attemptOutcome{event.Attempt, event.Outcome},
+		)
+
+		if event.Timestamp.Before(lastTimestamp) {
+			t.Errorf(
+				"event timestamp [%v] is before the previous one [%v]",
+				event.Timestamp,
+				lastTimestamp,
+			)
+		}
+		lastTimestamp = event.Timestamp
+	}
+
+	if !reflect.DeepEqual(expectedOutcomes, actualOutcomes) {
+		t.Errorf(
+			"unexpected events\n"+
+				"expected: [%+v]\n"+
+				"actual:   [%+v]",
+			expectedOutcomes,
+			actualOutcomes,
+		)
+	}
+}
+
+func TestDkgRetryLoop_AttemptsLimitReached(t *testing.T) {
+	groupParameters := &GroupParameters{
+		GroupSize:       10,
+		GroupQuorum:     8,
+		HonestThreshold: 6,
+	}
+
+	selectedOperators := make(chain.Addresses, 0)
+	membersIndexes := make([]group.MemberIndex, 0)
+	for i := 1; i <= groupParameters.GroupSize; i++ {
+		selectedOperators = append(
+			selectedOperators,
+			chain.Address(fmt.Sprintf("address-%v", i)),
+		)
+		membersIndexes = append(membersIndexes, group.MemberIndex(i))
+	}
+
+	announcer := &mockDkgAnnouncer{
+		outgoingAnnouncements: make(map[string]group.MemberIndex),
+		incomingAnnouncementsFn: func(sessionID string) ([]group.MemberIndex, error) {
+			return membersIndexes, nil
+		},
+	}
+
+	attemptsLimit := uint(3)
+
+	retryLoop := newDkgRetryLoop(
+		&testutils.MockLogger{},
+		big.NewInt(100),
+		200,
+		1,
+		selectedOperators,
+		groupParameters,
+		announcer,
+		attemptsLimit,
+	)
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelCtx()
+
+	attemptFnInvocations := 0
+
+	_, err := retryLoop.start(
+		ctx,
+		func(ctx context.Context, attemptStartBlock uint64) error {
+			return nil
+		},
+		func(params *dkgAttemptParams) (*dkg.Result, error) {
+			attemptFnInvocations++
+			return nil, fmt.Errorf("unexpected error")
+		},
+	)
+	if !errors.Is(err, ErrMaxAttemptsExceeded) {
+		t.Fatalf(
+			"unexpected error\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			ErrMaxAttemptsExceeded,
+			err,
+		)
+	}
+
+	testutils.AssertIntsEqual(
+		t,
+		"attempt function invocations",
+		int(attemptsLimit),
+		attemptFnInvocations,
+	)
+}
+
+func TestDkgRetryLoop_AnnouncerTimeout(t *testing.T) {
+	groupParameters := &GroupParameters{
+		GroupSize:       5,
+		GroupQuorum:     4,
+		HonestThreshold: 3,
+	}
+
+	operatorPrivateKey, operatorPublicKey, err := operator.GenerateKeyPair(
+		local_v1.DefaultCurve,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	localChain := ConnectWithKey(operatorPrivateKey)
+
+	operatorAddress, err := localChain.Signing().PublicKeyToAddress(
+		operatorPublicKey,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	selectedOperators := make(chain.Addresses, groupParameters.GroupSize)
+	for i := range selectedOperators {
+		selectedOperators[i] = operatorAddress
+	}
+
+	broadcastChannel, err := local.ConnectWithKey(operatorPublicKey).
+		BroadcastChannelFor("dkg-announcer-timeout-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	announcer.RegisterUnmarshaller(broadcastChannel)
+
+	// Only one member of the group announces its readiness so, the
+	// announcement phase of each attempt can end only with a non-quorum.
+	dkgAnnouncer := announcer.New(
+		fmt.Sprintf("%v-%v", ProtocolName, "dkg"),
+		broadcastChannel,
+		group.NewMembershipValidator(
+			&testutils.MockLogger{},
+			selectedOperators,
+			localChain.Signing(),
+		),
+		announcer.WithTimeout(3*local.RetransmissionTick),
+	)
+
+	attemptsLimit := uint(2)
+	startBlock := uint64(200)
+
+	retryLoop := newDkgRetryLoop(
+		&testutils.MockLogger{},
+		big.NewInt(100),
+		startBlock,
+		1,
+		selectedOperators,
+		groupParameters,
+		dkgAnnouncer,
+		attemptsLimit,
+	)
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelCtx()
+
+	attemptFnInvocations := 0
+
+	_, err = retryLoop.start(
+		ctx,
+		func(ctx context.Context, block uint64) error {
+			attemptOffset := (block - startBlock) %
+				uint64(dkgAttemptMaximumBlocks())
+
+			// Announcement start blocks are reached immediately while the
+			// announcement end blocks are never reached. That way, only
+			// the announcer timeout can end the announcement phase.
+			if attemptOffset == dkgAttemptAnnouncementDelayBlocks {
+				return nil
+			}
+
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		func(params *dkgAttemptParams) (*dkg.Result, error) {
+			attemptFnInvocations++
+			return &dkg.Result{}, nil
+		},
+	)
+	if !errors.Is(err, ErrMaxAttemptsExceeded) {
+		t.Fatalf(
+			"unexpected error\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			ErrMaxAttemptsExceeded,
+			err,
+		)
+	}
+
+	// Reaching the attempts limit means the loop moved to the next attempt
+	// after each announcement timeout. None of the attempts had a quorum
+	// of ready members so, no attempt function was invoked.
+	testutils.AssertIntsEqual(
+		t,
+		"attempt function invocations",
+		0,
+		attemptFnInvocations,
+	)
+}
+
+func TestDkgRetryLoop_ContextCancelledAfterFailedAttempt(t *testing.T) {
+	groupParameters := &GroupParameters{
+		GroupSize:       10,
+		GroupQuorum:     8,
+		HonestThreshold: 6,
+	}
+
+	selectedOperators := make(chain.Addresses, 0)
+	membersIndexes := make([]group.MemberIndex, 0)
+	for i := 1; i <= groupParameters.GroupSize; i++ {
+		selectedOperators = append(
+			selectedOperators,
+			chain.Address(fmt.Sprintf("address-%v", i)),
+		)
+		membersIndexes = append(membersIndexes, group.MemberIndex(i))
+	}
+
+	announcer := &mockDkgAnnouncer{
+		outgoingAnnouncements: make(map[string]group.MemberIndex),
+		incomingAnnouncementsFn: func(sessionID string) ([]group.MemberIndex, error) {
+			return membersIndexes, nil
+		},
+	}
+
+	retryLoop := newDkgRetryLoop(
+		&testutils.MockLogger{},
+		big.NewInt(100),
+		200,
+		1,
+		selectedOperators,
+		groupParameters,
+		announcer,
+		0, // no limit
+	)
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	attemptFnInvocations := 0
+
+	result, err := retryLoop.start(
+		ctx,
+		func(ctx context.Context, attemptStartBlock uint64) error {
+			return nil
+		},
+		func(params *dkgAttemptParams) (*dkg.Result, error) {
+			attemptFnInvocations++
+
+			// Cancel the loop context while the attempt is in progress.
+			cancelCtx()
+
+			return nil, fmt.Errorf("unexpected error")
+		},
+	)
+
+	if !reflect.DeepEqual(context.Canceled, err) {
+		t.Errorf(
+			"unexpected error\n"+
+				"expected: [%+v]\n"+
+				"actual:   [%+v]",
+			context.Canceled,
+			err,
+		)
+	}
+
+	if result != nil {
+		t.Errorf("unexpected result: [%+v]", result)
+	}
+
+	testutils.AssertIntsEqual(
+		t,
+		"attempt function invocations",
+		1,
+		attemptFnInvocations,
+	)
+	testutils.AssertUintsEqual(
+		t,
+		"attempt count",
+		2,
+		uint64(retryLoop.AttemptCount()),
+	)
+}
+
+func TestDkgRetryLoop_WaitForBlockFailed(t *testing.T) {
+	groupParameters := &GroupParameters{
+		GroupSize:       10,
+		GroupQuorum:     8,
+		HonestThreshold: 6,
+	}
+
+	selectedOperators := make(chain.Addresses, 0)
+	for i := 1; i <= groupParameters.GroupSize; i++ {
+		selectedOperators = append(
+			selectedOperators,
+			chain.Address(fmt.Sprintf("address-%v", i)),
+		)
+	}
+
+	announcer := &mockDkgAnnouncer{
+		outgoingAnnouncements: make(map[string]group.MemberIndex),
+		incomingAnnouncementsFn: func(sessionID string) ([]group.MemberIndex, error) {
+			t.Fatal("announcement should not be performed")
+			return nil, nil
+		},
+	}
+
+	retryLoop := newDkgRetryLoop(
+		&testutils.MockLogger{},
+		big.NewInt(100),
+		200,
+		1,
+		selectedOperators,
+		groupParameters,
+		announcer,
+		0, // no limit
+	)
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	result, err := retryLoop.start(
+		ctx,
+		func(ctx context.Context, attemptStartBlock uint64) error {
+			return fmt.Errorf("block waiter error")
+		},
+		func(params *dkgAttemptParams) (*dkg.Result, error) {
+			t.Fatal("attempt should not be performed")
+			return nil, nil
+		},
+	)
+
+	expectedErr := fmt.Errorf(
+		"failed waiting for announcement start block [201] " +
+			"for attempt [1]: [block waiter error]",
+	)
+	if !reflect.DeepEqual(expectedErr, err) {
+		t.Errorf(
+			"unexpected error\n"+
+				"expected: [%+v]\n"+
+				"actual:   [%+v]",
+			expectedErr,
+			err,
+		)
+	}
+
+	if result != nil {
+		t.Errorf("unexpected result: [%+v]", result)
+	}
+
+	testutils.AssertIntsEqual(
+		t,
+		"outgoing announcements count",
+		0,
+		len(announcer.outgoingAnnouncements),
+	)
+}
+
+func TestDkgAttemptParams_String(t *testing.T) {
+	var tests = map[string]struct {
+		excludedMembersIndexes []group.MemberIndex
+		expectedString         string
+	}{
+		"no excluded members": {
+			excludedMembersIndexes: []group.MemberIndex{},
+			expectedString: "attempt [3] with start block [100], " +
+				"timeout block [200] and excluded members []",
+		},
+		"one excluded member": {
+			excludedMembersIndexes: []group.MemberIndex{7},
+			expectedString: "attempt [3] with start block [100], " +
+				"timeout block [200] and excluded members [7]",
+		},
+		"multiple excluded members": {
+			excludedMembersIndexes: []group.MemberIndex{2, 5, 64},
+			expectedString: "attempt [3] with start block [100], " +
+				"timeout block [200] and excluded members [2, 5, 64]",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			params := &dkgAttemptParams{
+				number:                 3,
+				startBlock:             100,
+				timeoutBlock:           200,
+				excludedMembersIndexes: test.excludedMembersIndexes,
+			}
+
+			testutils.AssertStringsEqual(
+				t,
+				"attempt params string",
+				test.expectedString,
+				params.String(),
+			)
+		})
+	}
+}
+
 type mockDkgAnnouncer struct {
 	// outgoingAnnouncements holds all announcements that are sent by the
 	// announcer.
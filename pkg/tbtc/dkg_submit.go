@@ -3,6 +3,7 @@ package tbtc
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/ipfs/go-log/v2"
 	"github.com/keep-network/keep-core/pkg/protocol/group"
@@ -82,6 +83,12 @@ type dkgResultSubmitter struct {
 	groupParameters      *GroupParameters
 	groupSelectionResult *GroupSelectionResult
 
+	// gasPriceOracle is used to determine the gas price of the submission.
+	gasPriceOracle GasPriceOracle
+	// maxGasPrice is the cap, in wei, applied to the gas price suggested
+	// by the gasPriceOracle.
+	maxGasPrice *big.Int
+
 	waitForBlockFn waitForBlockFn
 }
 
@@ -90,6 +97,8 @@ func newDkgResultSubmitter(
 	chain Chain,
 	groupParameters *GroupParameters,
 	groupSelectionResult *GroupSelectionResult,
+	gasPriceOracle GasPriceOracle,
+	maxGasPrice *big.Int,
 	waitForBlockFn waitForBlockFn,
 ) *dkgResultSubmitter {
 	return &dkgResultSubmitter{
@@ -97,6 +106,8 @@ func newDkgResultSubmitter(
 		chain:                chain,
 		groupSelectionResult: groupSelectionResult,
 		groupParameters:      groupParameters,
+		gasPriceOracle:       gasPriceOracle,
+		maxGasPrice:          maxGasPrice,
 		waitForBlockFn:       waitForBlockFn,
 	}
 }
@@ -161,16 +172,11 @@ func (drs *dkgResultSubmitter) SubmitResult(
 		return fmt.Errorf("invalid DKG result")
 	}
 
-	blockCounter, err := drs.chain.BlockCounter()
-	if err != nil {
-		return err
-	}
-
 	// We can't determine a common block at which the publication starts.
 	// However, all we want here is to ensure the members does not submit
 	// in the same time. This can be achieved by simply using the index-based
 	// delay starting from the current block.
-	currentBlock, err := blockCounter.CurrentBlock()
+	currentBlock, err := getCurrentBlock(drs.chain)
 	if err != nil {
 		return fmt.Errorf("cannot get current block: [%v]", err)
 	}
@@ -203,12 +209,48 @@ func (drs *dkgResultSubmitter) SubmitResult(
 		return nil
 	}
 
+	gasPrice := drs.gasPrice(ctx, memberIndex)
+
 	drs.dkgLogger.Infof(
 		"[member:%v] submitting DKG result with [%v] supporting "+
-			"member signatures",
+			"member signatures and gas price [%v]",
 		memberIndex,
 		len(signatures),
+		gasPrice,
 	)
 
-	return drs.chain.SubmitDKGResult(dkgResult)
+	return drs.chain.SubmitDKGResult(dkgResult, gasPrice)
+}
+
+// gasPrice determines the gas price of the DKG result submission using the
+// gas price oracle. The suggested gas price is capped at the maximum gas
+// price. If the oracle fails, nil is returned and the submission falls back
+// to the chain's default pricing.
+func (drs *dkgResultSubmitter) gasPrice(
+	ctx context.Context,
+	memberIndex group.MemberIndex,
+) *big.Int {
+	suggestedGasPrice, err := drs.gasPriceOracle.SuggestGasPrice(ctx)
+	if err != nil {
+		drs.dkgLogger.Warnf(
+			"[member:%v] cannot get suggested gas price; "+
+				"falling back to default pricing: [%v]",
+			memberIndex,
+			err,
+		)
+		return nil
+	}
+
+	if drs.maxGasPrice != nil && suggestedGasPrice.Cmp(drs.maxGasPrice) > 0 {
+		drs.dkgLogger.Warnf(
+			"[member:%v] suggested gas price [%v] exceeds the maximum "+
+				"gas price [%v]; using the maximum",
+			memberIndex,
+			suggestedGasPrice,
+			drs.maxGasPrice,
+		)
+		return new(big.Int).Set(drs.maxGasPrice)
+	}
+
+	return suggestedGasPrice
 }
@@ -3,6 +3,7 @@ package tbtc
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
@@ -191,6 +192,78 @@ func TestVerifySignature_VerificationFailure(t *testing.T) {
 	}
 }
 
+func TestVerifySignature_VerificationFailure_TamperedResultHash(t *testing.T) {
+	chain := Connect()
+	dkgStartBlock := uint64(2000)
+	dkgResultSigner := newDkgResultSigner(chain, dkgStartBlock)
+
+	testData, err := tecdsatest.LoadPrivateKeyShareTestFixtures(1)
+	if err != nil {
+		t.Fatalf("failed to load test data: [%v]", err)
+	}
+
+	result := &dkg.Result{
+		Group:           group.NewGroup(2, 5),
+		PrivateKeyShare: tecdsa.NewPrivateKeyShare(testData[0]),
+	}
+	signedResult, err := dkgResultSigner.SignResult(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Flip a bit of the result hash to simulate a signature presented for
+	// a tampered result.
+	signedResult.ResultHash[0] ^= 0x01
+
+	verificationSuccessful, err := dkgResultSigner.VerifySignature(signedResult)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if verificationSuccessful {
+		t.Errorf(
+			"expected unsuccessful verification of signature, " +
+				"but it was successful",
+		)
+	}
+}
+
+func TestVerifySignature_VerificationFailure_AnotherMemberPublicKey(t *testing.T) {
+	chain := Connect()
+	dkgStartBlock := uint64(2000)
+	dkgResultSigner := newDkgResultSigner(chain, dkgStartBlock)
+
+	testData, err := tecdsatest.LoadPrivateKeyShareTestFixtures(1)
+	if err != nil {
+		t.Fatalf("failed to load test data: [%v]", err)
+	}
+
+	result := &dkg.Result{
+		Group:           group.NewGroup(2, 5),
+		PrivateKeyShare: tecdsa.NewPrivateKeyShare(testData[0]),
+	}
+	signedResult, err := dkgResultSigner.SignResult(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Present the signature as if it was produced by another group member
+	// controlling a different operator key.
+	signedResult.PublicKey = Connect().Signing().PublicKey()
+
+	verificationSuccessful, err := dkgResultSigner.VerifySignature(signedResult)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if verificationSuccessful {
+		t.Errorf(
+			"expected unsuccessful verification of signature, " +
+				"but it was successful",
+		)
+	}
+}
+
 func TestVerifySignature_VerificationError(t *testing.T) {
 	chain := Connect()
 	dkgStartBlock := uint64(2000)
@@ -272,6 +345,8 @@ func TestSubmitResult_MemberSubmitsResult(t *testing.T) {
 		localChain,
 		groupParameters,
 		groupSelectionResult,
+		localChain,
+		nil,
 		testWaitForBlockFn(localChain),
 	)
 
@@ -323,6 +398,134 @@ func TestSubmitResult_MemberSubmitsResult(t *testing.T) {
 	}
 }
 
+func TestSubmitResult_GasPrice(t *testing.T) {
+	maxGasPrice := big.NewInt(100)
+
+	var tests = map[string]struct {
+		oracle           *mockGasPriceOracle
+		expectedGasPrice *big.Int
+	}{
+		"suggested gas price below the maximum": {
+			oracle:           &mockGasPriceOracle{gasPrice: big.NewInt(99)},
+			expectedGasPrice: big.NewInt(99),
+		},
+		"suggested gas price equal to the maximum": {
+			oracle:           &mockGasPriceOracle{gasPrice: big.NewInt(100)},
+			expectedGasPrice: big.NewInt(100),
+		},
+		"suggested gas price above the maximum": {
+			oracle:           &mockGasPriceOracle{gasPrice: big.NewInt(101)},
+			expectedGasPrice: big.NewInt(100),
+		},
+		"gas price oracle error": {
+			oracle:           &mockGasPriceOracle{err: fmt.Errorf("oracle error")},
+			expectedGasPrice: nil,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			groupParameters := &GroupParameters{
+				GroupSize:       5,
+				GroupQuorum:     4,
+				HonestThreshold: 3,
+			}
+
+			localChain := Connect()
+
+			err := localChain.startDKG()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			operatorAddress, err := localChain.operatorAddress()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			operatorID, err := localChain.GetOperatorID(operatorAddress)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var operatorsIDs chain.OperatorIDs
+			var operatorsAddresses chain.Addresses
+
+			for memberIndex := uint8(1); int(memberIndex) <= groupParameters.GroupSize; memberIndex++ {
+				operatorsIDs = append(operatorsIDs, operatorID)
+				operatorsAddresses = append(operatorsAddresses, operatorAddress)
+			}
+
+			groupSelectionResult := &GroupSelectionResult{
+				OperatorsIDs:       operatorsIDs,
+				OperatorsAddresses: operatorsAddresses,
+			}
+
+			dkgResultSubmitter := newDkgResultSubmitter(
+				&testutils.MockLogger{},
+				localChain,
+				groupParameters,
+				groupSelectionResult,
+				test.oracle,
+				maxGasPrice,
+				testWaitForBlockFn(localChain),
+			)
+
+			testData, err := tecdsatest.LoadPrivateKeyShareTestFixtures(1)
+			if err != nil {
+				t.Fatalf("failed to load test data: [%v]", err)
+			}
+			result := &dkg.Result{
+				Group:           group.NewGroup(groupParameters.DishonestThreshold(), groupParameters.GroupSize),
+				PrivateKeyShare: tecdsa.NewPrivateKeyShare(testData[0]),
+			}
+
+			signatures := map[group.MemberIndex][]byte{
+				1: []byte("signature 1"),
+				2: []byte("signature 2"),
+				3: []byte("signature 3"),
+				4: []byte("signature 4"),
+			}
+
+			if err = localChain.setDKGResultValidity(true); err != nil {
+				t.Fatal(err)
+			}
+
+			err = dkgResultSubmitter.SubmitResult(
+				context.Background(),
+				group.MemberIndex(1),
+				result,
+				signatures,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(
+				test.expectedGasPrice,
+				localChain.dkgResultGasPrice,
+			) {
+				t.Errorf(
+					"unexpected gas price\nexpected: [%v]\nactual:   [%v]",
+					test.expectedGasPrice,
+					localChain.dkgResultGasPrice,
+				)
+			}
+		})
+	}
+}
+
+type mockGasPriceOracle struct {
+	gasPrice *big.Int
+	err      error
+}
+
+func (mgpo *mockGasPriceOracle) SuggestGasPrice(
+	ctx context.Context,
+) (*big.Int, error) {
+	return mgpo.gasPrice, mgpo.err
+}
+
 func TestSubmitResult_AnotherMemberSubmitsResult(t *testing.T) {
 	groupParameters := &GroupParameters{
 		GroupSize:       5,
@@ -365,6 +568,8 @@ func TestSubmitResult_AnotherMemberSubmitsResult(t *testing.T) {
 		localChain,
 		groupParameters,
 		groupSelectionResult,
+		localChain,
+		nil,
 		testWaitForBlockFn(localChain),
 	)
 
@@ -499,6 +704,8 @@ func TestSubmitResult_InvalidResult(t *testing.T) {
 		localChain,
 		groupParameters,
 		groupSelectionResult,
+		localChain,
+		nil,
 		testWaitForBlockFn(localChain),
 	)
 
@@ -585,6 +792,8 @@ func TestSubmitResult_ContextCancelled(t *testing.T) {
 		localChain,
 		groupParameters,
 		groupSelectionResult,
+		localChain,
+		nil,
 		testWaitForBlockFn(localChain),
 	)
 
@@ -667,6 +876,8 @@ func TestSubmitResult_TooFewSignatures(t *testing.T) {
 		localChain,
 		groupParameters,
 		groupSelectionResult,
+		localChain,
+		nil,
 		testWaitForBlockFn(localChain),
 	)
 
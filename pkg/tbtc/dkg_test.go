@@ -3,6 +3,7 @@ package tbtc
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
@@ -311,7 +312,7 @@ func TestDkgExecutor_ExecuteDkgValidation(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			err = localChain.SubmitDKGResult(dkgResult)
+			err = localChain.SubmitDKGResult(dkgResult, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -385,6 +386,122 @@ func TestDkgExecutor_ExecuteDkgValidation(t *testing.T) {
 	}
 }
 
+func TestDkgExecutor_RetryAttempts(t *testing.T) {
+	executor := &dkgExecutor{
+		retryLoops: make(map[string]*dkgRetryLoop),
+	}
+
+	newRetryLoop := func(seed int64, attemptCounter uint) *dkgRetryLoop {
+		return &dkgRetryLoop{
+			seed:           big.NewInt(seed),
+			attemptCounter: attemptCounter,
+		}
+	}
+
+	retryLoop1 := newRetryLoop(10, 3)
+	// Loop of another member controlled by the node, for the same seed.
+	retryLoop1Duplicate := newRetryLoop(10, 3)
+	retryLoop2 := newRetryLoop(11, 1)
+
+	executor.registerRetryLoop(retryLoop1)
+	executor.registerRetryLoop(retryLoop1Duplicate)
+	executor.registerRetryLoop(retryLoop2)
+
+	expectedAttempts := map[string]uint{"a": 3, "b": 1}
+	if !reflect.DeepEqual(expectedAttempts, executor.retryAttempts()) {
+		t.Errorf(
+			"unexpected retry attempts\nexpected: %v\nactual:   %v",
+			expectedAttempts,
+			executor.retryAttempts(),
+		)
+	}
+	testutils.AssertUintsEqual(
+		t,
+		"retry attempts total",
+		4,
+		uint64(executor.retryAttemptsTotal()),
+	)
+
+	executor.unregisterRetryLoop(retryLoop1Duplicate)
+	executor.unregisterRetryLoop(retryLoop1)
+
+	expectedAttempts = map[string]uint{"b": 1}
+	if !reflect.DeepEqual(expectedAttempts, executor.retryAttempts()) {
+		t.Errorf(
+			"unexpected retry attempts\nexpected: %v\nactual:   %v",
+			expectedAttempts,
+			executor.retryAttempts(),
+		)
+	}
+	// Attempts of completed loops are still included in the total.
+	testutils.AssertUintsEqual(
+		t,
+		"retry attempts total",
+		4,
+		uint64(executor.retryAttemptsTotal()),
+	)
+}
+
+func TestDkgExecutor_LastAttempt(t *testing.T) {
+	executor := &dkgExecutor{
+		retryLoops:    make(map[string]*dkgRetryLoop),
+		attemptEvents: make(chan DKGAttemptEvent, dkgAttemptEventsBuffer),
+	}
+
+	recordingDone := make(chan struct{})
+	go func() {
+		executor.recordAttemptEvents()
+		close(recordingDone)
+	}()
+
+	if executor.lastAttempt() != nil {
+		t.Fatal("no DKG attempt is expected yet")
+	}
+
+	newRetryLoop := func() *dkgRetryLoop {
+		return &dkgRetryLoop{
+			logger:         &testutils.MockLogger{},
+			seed:           big.NewInt(10),
+			attemptCounter: 2,
+		}
+	}
+
+	retryLoop := newRetryLoop()
+	// Loop of another member controlled by the node, for the same seed.
+	retryLoopDuplicate := newRetryLoop()
+
+	executor.registerRetryLoop(retryLoop)
+	executor.registerRetryLoop(retryLoopDuplicate)
+
+	if retryLoopDuplicate.events != nil {
+		t.Fatal("untracked retry loop should not report events")
+	}
+
+	retryLoop.emitEvent(DKGAttemptStarted)
+	retryLoop.emitEvent(DKGAttemptFailed)
+
+	close(executor.attemptEvents)
+	<-recordingDone
+
+	lastAttempt := executor.lastAttempt()
+	if lastAttempt == nil {
+		t.Fatal("last DKG attempt is expected")
+	}
+
+	testutils.AssertUintsEqual(
+		t,
+		"last attempt",
+		2,
+		uint64(lastAttempt.Attempt),
+	)
+	testutils.AssertStringsEqual(
+		t,
+		"last attempt outcome",
+		string(DKGAttemptFailed),
+		string(lastAttempt.Outcome),
+	)
+}
+
 func TestFinalSigningGroup(t *testing.T) {
 	groupParameters := &GroupParameters{
 		GroupSize:       5,
@@ -0,0 +1,69 @@
+package tbtc
+
+import (
+	"sync"
+)
+
+// goroutineTracker keeps track of the goroutines launched by the node that
+// are still running. Goroutines are counted separately for each protocol
+// phase they were launched for, e.g. handling of a specific chain event.
+// The tracker helps to detect unbounded goroutine growth.
+type goroutineTracker struct {
+	mutex  sync.Mutex
+	active map[string]int
+}
+
+func newGoroutineTracker() *goroutineTracker {
+	return &goroutineTracker{
+		active: make(map[string]int),
+	}
+}
+
+// launch runs the given function in a new goroutine and keeps it counted
+// as active for the given phase until the function returns.
+func (gt *goroutineTracker) launch(phase string, fn func()) {
+	gt.mutex.Lock()
+	gt.active[phase]++
+	gt.mutex.Unlock()
+
+	go func() {
+		defer func() {
+			gt.mutex.Lock()
+			defer gt.mutex.Unlock()
+
+			gt.active[phase]--
+			if gt.active[phase] == 0 {
+				delete(gt.active, phase)
+			}
+		}()
+
+		fn()
+	}()
+}
+
+// count returns the total number of active goroutines.
+func (gt *goroutineTracker) count() int {
+	gt.mutex.Lock()
+	defer gt.mutex.Unlock()
+
+	total := 0
+	for _, active := range gt.active {
+		total += active
+	}
+
+	return total
+}
+
+// countByPhase returns the number of active goroutines for each phase
+// having at least one active goroutine.
+func (gt *goroutineTracker) countByPhase() map[string]int {
+	gt.mutex.Lock()
+	defer gt.mutex.Unlock()
+
+	result := make(map[string]int, len(gt.active))
+	for phase, active := range gt.active {
+		result[phase] = active
+	}
+
+	return result
+}
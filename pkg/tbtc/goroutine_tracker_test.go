@@ -0,0 +1,103 @@
+package tbtc
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/internal/testutils"
+)
+
+func TestGoroutineTracker_Launch(t *testing.T) {
+	tracker := newGoroutineTracker()
+
+	release := make(chan struct{})
+	started := make(chan struct{}, 3)
+
+	blockingFn := func() {
+		started <- struct{}{}
+		<-release
+	}
+
+	tracker.launch("dkg_started", blockingFn)
+	tracker.launch("dkg_started", blockingFn)
+	tracker.launch("wallet_closed", blockingFn)
+
+	for i := 0; i < 3; i++ {
+		<-started
+	}
+
+	testutils.AssertIntsEqual(t, "active goroutines", 3, tracker.count())
+
+	expectedCountByPhase := map[string]int{
+		"dkg_started":   2,
+		"wallet_closed": 1,
+	}
+	if !reflect.DeepEqual(expectedCountByPhase, tracker.countByPhase()) {
+		t.Errorf(
+			"unexpected active goroutines by phase\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			expectedCountByPhase,
+			tracker.countByPhase(),
+		)
+	}
+
+	close(release)
+
+	waitForNoActiveGoroutines(t, tracker)
+}
+
+func TestGoroutineTracker_Launch_ImmediateReturn(t *testing.T) {
+	tracker := newGoroutineTracker()
+
+	for i := 0; i < 100; i++ {
+		tracker.launch("dkg_member", func() {})
+	}
+
+	waitForNoActiveGoroutines(t, tracker)
+
+	testutils.AssertIntsEqual(
+		t,
+		"phases with active goroutines",
+		0,
+		len(tracker.countByPhase()),
+	)
+}
+
+func TestNode_ActiveGoroutines(t *testing.T) {
+	node := &node{goroutineTracker: newGoroutineTracker()}
+
+	testutils.AssertIntsEqual(t, "active goroutines", 0, node.ActiveGoroutines())
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	node.goroutineTracker.launch("heartbeat_requested", func() {
+		close(started)
+		<-release
+	})
+
+	<-started
+
+	testutils.AssertIntsEqual(t, "active goroutines", 1, node.ActiveGoroutines())
+
+	close(release)
+
+	waitForNoActiveGoroutines(t, node.goroutineTracker)
+}
+
+func waitForNoActiveGoroutines(t *testing.T, tracker *goroutineTracker) {
+	deadline := time.Now().Add(1 * time.Second)
+
+	for tracker.count() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf(
+				"goroutines still active: [%v]",
+				tracker.countByPhase(),
+			)
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
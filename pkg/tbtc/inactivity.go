@@ -394,16 +394,11 @@ func (ics *inactivityClaimSubmitter) SubmitClaim(
 		return fmt.Errorf("could not assemble inactivity chain claim [%w]", err)
 	}
 
-	blockCounter, err := ics.chain.BlockCounter()
-	if err != nil {
-		return err
-	}
-
 	// We can't determine a common block at which the publication starts.
 	// However, all we want here is to ensure the members does not submit
 	// in the same time. This can be achieved by simply using the index-based
 	// delay starting from the current block.
-	currentBlock, err := blockCounter.CurrentBlock()
+	currentBlock, err := getCurrentBlock(ics.chain)
 	if err != nil {
 		return fmt.Errorf("cannot get current block: [%v]", err)
 	}
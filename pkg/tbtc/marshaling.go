@@ -21,6 +21,16 @@ var errIncompatiblePublicKey = fmt.Errorf(
 	"public key is not tECDSA compatible and will cause unmarshaling error",
 )
 
+var errNilPublicKey = fmt.Errorf("public key is nil")
+
+var errInvalidPublicKeyPoint = fmt.Errorf(
+	"public key is not a valid point on the curve",
+)
+
+var errInvalidPublicKeyBytes = fmt.Errorf(
+	"bytes are not a valid uncompressed tECDSA public key",
+)
+
 // Marshal converts the signer to a byte array.
 func (s *signer) Marshal() ([]byte, error) {
 	walletPublicKey, err := marshalPublicKey(s.wallet.publicKey)
@@ -61,7 +71,10 @@ func (s *signer) Unmarshal(bytes []byte) error {
 		return fmt.Errorf("cannot unmarshal signer: [%w]", err)
 	}
 
-	walletPublicKey := unmarshalPublicKey(pbSigner.Wallet.PublicKey)
+	walletPublicKey, err := unmarshalPublicKey(pbSigner.Wallet.PublicKey)
+	if err != nil {
+		return fmt.Errorf("cannot unmarshal wallet public key: [%w]", err)
+	}
 
 	walletSigningGroupOperators := make(
 		[]chain.Address,
@@ -464,12 +477,25 @@ func (mfsp *MovedFundsSweepProposal) Unmarshal(data []byte) error {
 }
 
 // marshalPublicKey converts an ECDSA public key to a byte
-// array (uncompressed).
+// array (uncompressed). Returns an error if the public key is nil, does not
+// use the tECDSA curve, or is not a valid point on that curve.
 func marshalPublicKey(publicKey *ecdsa.PublicKey) ([]byte, error) {
+	if publicKey == nil {
+		return nil, errNilPublicKey
+	}
+
 	if publicKey.Curve.Params().Name != tecdsa.Curve.Params().Name {
 		return nil, errIncompatiblePublicKey
 	}
 
+	// The point at infinity (identity) or any other point that does not lie
+	// on the curve cannot be unmarshaled back so, reject it upfront.
+	if publicKey.X == nil ||
+		publicKey.Y == nil ||
+		!publicKey.Curve.IsOnCurve(publicKey.X, publicKey.Y) {
+		return nil, errInvalidPublicKeyPoint
+	}
+
 	return elliptic.Marshal(
 		publicKey.Curve,
 		publicKey.X,
@@ -478,18 +504,22 @@ func marshalPublicKey(publicKey *ecdsa.PublicKey) ([]byte, error) {
 }
 
 // unmarshalPublicKey converts a byte array (uncompressed) to an ECDSA
-// public key.
-func unmarshalPublicKey(bytes []byte) *ecdsa.PublicKey {
+// public key. Returns an error if the byte array is not a valid uncompressed
+// representation of a point on the tECDSA curve.
+func unmarshalPublicKey(bytes []byte) (*ecdsa.PublicKey, error) {
 	x, y := elliptic.Unmarshal(
 		tecdsa.Curve,
 		bytes,
 	)
+	if x == nil {
+		return nil, errInvalidPublicKeyBytes
+	}
 
 	return &ecdsa.PublicKey{
 		Curve: tecdsa.Curve,
 		X:     x,
 		Y:     y,
-	}
+	}, nil
 }
 
 func validateMemberIndex(protoIndex uint32) error {
@@ -3,6 +3,7 @@ package tbtc
 import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
+	"crypto/rand"
 	"encoding/hex"
 	"math/big"
 	"reflect"
@@ -48,6 +49,179 @@ func TestSignerMarshalling_NonTECDSAKey(t *testing.T) {
 	testutils.AssertErrorsSame(t, errIncompatiblePublicKey, err)
 }
 
+func TestMarshalPublicKey(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(tecdsa.Curve, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p256 := elliptic.P256()
+
+	var tests = map[string]struct {
+		publicKey     *ecdsa.PublicKey
+		expectedError error
+	}{
+		"valid secp256k1 key": {
+			publicKey:     &privateKey.PublicKey,
+			expectedError: nil,
+		},
+		"nil key": {
+			publicKey:     nil,
+			expectedError: errNilPublicKey,
+		},
+		"non-secp256k1 key": {
+			publicKey: &ecdsa.PublicKey{
+				Curve: p256,
+				X:     p256.Params().Gx,
+				Y:     p256.Params().Gy,
+			},
+			expectedError: errIncompatiblePublicKey,
+		},
+		"identity point": {
+			publicKey: &ecdsa.PublicKey{
+				Curve: tecdsa.Curve,
+				X:     big.NewInt(0),
+				Y:     big.NewInt(0),
+			},
+			expectedError: errInvalidPublicKeyPoint,
+		},
+		"nil coordinates": {
+			publicKey: &ecdsa.PublicKey{
+				Curve: tecdsa.Curve,
+			},
+			expectedError: errInvalidPublicKeyPoint,
+		},
+		"point not on curve": {
+			publicKey: &ecdsa.PublicKey{
+				Curve: tecdsa.Curve,
+				X:     privateKey.X,
+				Y:     new(big.Int).Add(privateKey.Y, big.NewInt(1)),
+			},
+			expectedError: errInvalidPublicKeyPoint,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			bytes, err := marshalPublicKey(test.publicKey)
+
+			testutils.AssertErrorsSame(t, test.expectedError, err)
+
+			if test.expectedError != nil {
+				return
+			}
+
+			// Uncompressed form: 0x04 prefix followed by 32-byte X and Y.
+			testutils.AssertIntsEqual(t, "marshaled key length", 65, len(bytes))
+			testutils.AssertIntsEqual(t, "marshaled key prefix", 0x04, int(bytes[0]))
+
+			unmarshaled, err := unmarshalPublicKey(bytes)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !test.publicKey.Equal(unmarshaled) {
+				t.Errorf(
+					"unexpected unmarshaled key\nexpected: %v\nactual:   %v",
+					test.publicKey,
+					unmarshaled,
+				)
+			}
+		})
+	}
+}
+
+func TestUnmarshalPublicKey(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(tecdsa.Curve, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	validBytes := elliptic.Marshal(tecdsa.Curve, privateKey.X, privateKey.Y)
+
+	notOnCurveBytes := make([]byte, len(validBytes))
+	copy(notOnCurveBytes, validBytes)
+	notOnCurveBytes[len(notOnCurveBytes)-1] ^= 0x01
+
+	compressedBytes := elliptic.MarshalCompressed(
+		tecdsa.Curve,
+		privateKey.X,
+		privateKey.Y,
+	)
+
+	var tests = map[string]struct {
+		bytes         []byte
+		expectedError error
+	}{
+		"valid uncompressed key": {
+			bytes:         validBytes,
+			expectedError: nil,
+		},
+		"nil bytes": {
+			bytes:         nil,
+			expectedError: errInvalidPublicKeyBytes,
+		},
+		"empty bytes": {
+			bytes:         []byte{},
+			expectedError: errInvalidPublicKeyBytes,
+		},
+		"compressed key": {
+			bytes:         compressedBytes,
+			expectedError: errInvalidPublicKeyBytes,
+		},
+		"truncated key": {
+			bytes:         validBytes[:64],
+			expectedError: errInvalidPublicKeyBytes,
+		},
+		"point not on curve": {
+			bytes:         notOnCurveBytes,
+			expectedError: errInvalidPublicKeyBytes,
+		},
+		"identity point": {
+			bytes:         append([]byte{0x04}, make([]byte, 64)...),
+			expectedError: errInvalidPublicKeyBytes,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			publicKey, err := unmarshalPublicKey(test.bytes)
+
+			testutils.AssertErrorsSame(t, test.expectedError, err)
+
+			if test.expectedError != nil {
+				return
+			}
+
+			if !privateKey.PublicKey.Equal(publicKey) {
+				t.Errorf(
+					"unexpected unmarshaled key\nexpected: %v\nactual:   %v",
+					privateKey.PublicKey,
+					publicKey,
+				)
+			}
+
+			marshaled, err := marshalPublicKey(publicKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			testutils.AssertBytesEqual(t, test.bytes, marshaled)
+		})
+	}
+}
+
+// unmarshalTestPublicKey unmarshals the given uncompressed public key and
+// fails the test if the key is invalid.
+func unmarshalTestPublicKey(t *testing.T, bytes []byte) *ecdsa.PublicKey {
+	publicKey, err := unmarshalPublicKey(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return publicKey
+}
+
 func TestSigningDoneMessage_MarshalingRoundtrip(t *testing.T) {
 	msg := &signingDoneMessage{
 		senderID:      group.MemberIndex(10),
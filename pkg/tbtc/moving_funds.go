@@ -1,6 +1,7 @@
 package tbtc
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"time"
@@ -77,7 +78,13 @@ func (mfp *MovingFundsProposal) ValidityBlocks() uint64 {
 // movingFundsAction is a walletAction implementation handling moving funds
 // requests from the wallet coordinator.
 type movingFundsAction struct {
-	logger   *zap.SugaredLogger
+	logger *zap.SugaredLogger
+
+	// ctx is cancelled when the moving funds is no longer expected by the
+	// chain, e.g. because it timed out. The action stops at the nearest
+	// step boundary.
+	ctx context.Context
+
 	chain    Chain
 	btcChain bitcoin.Chain
 
@@ -94,6 +101,7 @@ type movingFundsAction struct {
 }
 
 func newMovingFundsAction(
+	ctx context.Context,
 	logger *zap.SugaredLogger,
 	chain Chain,
 	btcChain bitcoin.Chain,
@@ -112,6 +120,7 @@ func newMovingFundsAction(
 	)
 
 	return &movingFundsAction{
+		ctx:                              ctx,
 		logger:                           logger,
 		chain:                            chain,
 		btcChain:                         btcChain,
@@ -215,6 +224,10 @@ func (mfa *movingFundsAction) execute() error {
 		)
 	}
 
+	if err := mfa.ctx.Err(); err != nil {
+		return fmt.Errorf("moving funds cancelled before signing: [%w]", err)
+	}
+
 	signTxLogger := mfa.logger.With(
 		zap.String("step", "signTransaction"),
 	)
@@ -496,12 +509,7 @@ func isWalletPendingMovingFundsTarget(
 		) ([]*MovingFundsCommitmentSubmittedEvent, error)
 	},
 ) (bool, error) {
-	blockCounter, err := chain.BlockCounter()
-	if err != nil {
-		return false, fmt.Errorf("failed to get block counter: [%w]", err)
-	}
-
-	currentBlockNumber, err := blockCounter.CurrentBlock()
+	currentBlockNumber, err := getCurrentBlock(chain)
 	if err != nil {
 		return false, fmt.Errorf(
 			"failed to get current block number: [%w]",
@@ -112,6 +112,7 @@ func TestMovingFundsAction_Execute(t *testing.T) {
 			)
 
 			action := newMovingFundsAction(
+				context.Background(),
 				logger.With(),
 				hostChain,
 				bitcoinChain,
@@ -7,12 +7,14 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"time"
 
 	"github.com/keep-network/keep-common/pkg/chain/ethereum"
 	"github.com/keep-network/keep-core/pkg/bitcoin"
 	"github.com/keep-network/keep-core/pkg/chain"
 
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 
 	"github.com/keep-network/keep-common/pkg/persistence"
 	"github.com/keep-network/keep-core/pkg/generator"
@@ -45,6 +47,20 @@ const (
 	// been definitely closed and the closing transaction will not be removed by
 	// a chain reorganization.
 	walletClosureConfirmationBlocks = 32
+
+	// fraudChallengeDefeatDelayStepBlocks determines the delay step in blocks
+	// that is used to calculate the fraud challenge defeat submission block
+	// of the given wallet member. The value of 5 blocks is roughly 1 minute,
+	// assuming 12 seconds per block.
+	fraudChallengeDefeatDelayStepBlocks = 5
+
+	// walletCreationsLookBackBlocks determines the period used when looking
+	// for wallets created in the past on the node startup. The value of
+	// 2628000 blocks is roughly 1 year, assuming 12 seconds per block.
+	// Wallets created earlier than that are not covered by the scan so, their
+	// creation blocks are not reported and their signing group operators
+	// are not restored.
+	walletCreationsLookBackBlocks = uint64(2628000)
 )
 
 // TODO: Unit tests for `node.go`.
@@ -68,6 +84,10 @@ type node struct {
 	// protocolLatch is used by dkgExecutor and signingExecutor.
 	protocolLatch *generator.ProtocolLatch
 
+	// scheduler manages the expensive number generator operations and keeps
+	// track of all protocols registered by the client.
+	scheduler *generator.Scheduler
+
 	// dkgExecutor encapsulates the logic of distributed key generation.
 	//
 	// dkgExecutor MUST NOT be used outside this struct.
@@ -99,6 +119,22 @@ type node struct {
 	// wallet.
 	signingExecutors map[string]*signingExecutor
 
+	// signingRateLimit is the maximum number of signing requests per second
+	// accepted by the signing executor of a single wallet.
+	signingRateLimit rate.Limit
+
+	// signerHealthCheckInterval is the interval of checks verifying the
+	// persisted key shares of the node's signers match their in-memory
+	// copies.
+	signerHealthCheckInterval time.Duration
+
+	movingFundsCancelsMutex sync.Mutex
+	// movingFundsCancels holds the functions cancelling the moving funds
+	// actions dispatched for specific wallets. The map key is the 20-byte
+	// public key hash of the wallet. An entry is replaced when a new moving
+	// funds action is dispatched for the same wallet.
+	movingFundsCancels map[[20]byte]context.CancelFunc
+
 	coordinationExecutorsMutex sync.Mutex
 	// coordinationExecutors is the cache holding coordination executors for
 	// specific wallets. The cache key is the uncompressed public key
@@ -111,6 +147,10 @@ type node struct {
 	// proposalGenerator is the implementation of the coordination proposal
 	// generator used by the node.
 	proposalGenerator CoordinationProposalGenerator
+
+	// goroutineTracker tracks goroutines launched by the node to handle
+	// protocol phases, e.g. chain events.
+	goroutineTracker *goroutineTracker
 }
 
 func newNode(
@@ -135,19 +175,34 @@ func newNode(
 	latch := generator.NewProtocolLatch()
 	scheduler.RegisterProtocol(latch)
 
+	signingRateLimit := rate.Limit(DefaultSigningRateLimit)
+	if config.SigningRateLimit > 0 {
+		signingRateLimit = rate.Limit(config.SigningRateLimit)
+	}
+
+	signerHealthCheckInterval := DefaultSignerHealthCheckInterval
+	if config.SignerHealthCheckInterval > 0 {
+		signerHealthCheckInterval = config.SignerHealthCheckInterval
+	}
+
 	node := &node{
-		groupParameters:          groupParameters,
-		chain:                    chain,
-		btcChain:                 btcChain,
-		netProvider:              netProvider,
-		walletRegistry:           walletRegistry,
-		walletDispatcher:         newWalletDispatcher(),
-		protocolLatch:            latch,
-		heartbeatFailureCounter:  newHeartbeatFailureCounter(),
-		signingExecutors:         make(map[string]*signingExecutor),
-		inactivityClaimExecutors: make(map[string]*inactivityClaimExecutor),
-		coordinationExecutors:    make(map[string]*coordinationExecutor),
-		proposalGenerator:        proposalGenerator,
+		groupParameters:           groupParameters,
+		chain:                     chain,
+		btcChain:                  btcChain,
+		netProvider:               netProvider,
+		walletRegistry:            walletRegistry,
+		walletDispatcher:          newWalletDispatcher(),
+		protocolLatch:             latch,
+		scheduler:                 scheduler,
+		heartbeatFailureCounter:   newHeartbeatFailureCounter(),
+		signingExecutors:          make(map[string]*signingExecutor),
+		movingFundsCancels:        make(map[[20]byte]context.CancelFunc),
+		signingRateLimit:          signingRateLimit,
+		signerHealthCheckInterval: signerHealthCheckInterval,
+		inactivityClaimExecutors:  make(map[string]*inactivityClaimExecutor),
+		coordinationExecutors:     make(map[string]*coordinationExecutor),
+		proposalGenerator:         proposalGenerator,
+		goroutineTracker:          newGoroutineTracker(),
 	}
 
 	// Archive any wallets that might have been closed or terminated while the
@@ -179,11 +234,168 @@ func newNode(
 		workPersistence,
 		scheduler,
 		node.waitForBlockHeight,
+		node.goroutineTracker,
 	)
 
 	return node, nil
 }
 
+// syncWalletCreations scans the chain for wallets created between the given
+// blocks and updates the node state with a single scan. Creation blocks of
+// wallets controlled by the node are recorded and signing group operators of
+// persisted signers that do not hold them are restored. Without the signing
+// group operators, the membership validator of the wallet's broadcast
+// channel cannot be constructed. Registered wallets the operator is a member
+// of, but the node has no persisted signers for, are reported as their key
+// material was lost. This function calls the chain so, it must not be used
+// on the node startup path or while serving the node status.
+func (n *node) syncWalletCreations(startBlock uint64, endBlock *uint64) error {
+	walletCreations, err := n.chain.PastWalletCreations(startBlock, endBlock)
+	if err != nil {
+		return fmt.Errorf("cannot get past wallet creations: [%v]", err)
+	}
+
+	for _, walletCreation := range walletCreations {
+		walletPublicKey := walletCreation.WalletPublicKey
+
+		signers := n.walletRegistry.getSigners(walletPublicKey)
+		if len(signers) == 0 {
+			continue
+		}
+
+		n.walletRegistry.setWalletCreationBlock(
+			walletPublicKey,
+			walletCreation.BlockNumber,
+		)
+
+		if len(signers[0].wallet.signingGroupOperators) > 0 {
+			continue
+		}
+
+		walletPublicKeyHash := bitcoin.PublicKeyHash(walletPublicKey)
+
+		if len(walletCreation.Operators) != n.groupParameters.GroupSize {
+			logger.Warnf(
+				"cannot restore signing group operators of wallet [0x%x]; "+
+					"unexpected signing group size [%v]",
+				walletPublicKeyHash,
+				len(walletCreation.Operators),
+			)
+			continue
+		}
+
+		n.walletRegistry.setSigningGroupOperators(
+			walletPublicKey,
+			walletCreation.Operators,
+		)
+
+		logger.Infof(
+			"signing group operators of wallet [0x%x] restored from the chain",
+			walletPublicKeyHash,
+		)
+	}
+
+	operatorAddress, err := n.operatorAddress()
+	if err != nil {
+		return fmt.Errorf("cannot get node's operator address: [%v]", err)
+	}
+
+	missingWallets, err := n.findWalletsWithoutSigners(
+		operatorAddress,
+		walletCreations,
+	)
+	if err != nil {
+		return fmt.Errorf("cannot cross-check persisted signers: [%v]", err)
+	}
+
+	for _, walletPublicKey := range missingWallets {
+		logger.Warnf(
+			"node is a member of wallet [0x%x] but has no persisted "+
+				"signers for it",
+			bitcoin.PublicKeyHash(walletPublicKey),
+		)
+	}
+
+	return nil
+}
+
+// findWalletsWithoutSigners returns public keys of the given created wallets
+// the operator is a non-misbehaved member of but the node has no persisted
+// signers for. Wallets that are no longer registered, i.e. closed or
+// terminated, are skipped.
+func (n *node) findWalletsWithoutSigners(
+	operatorAddress chain.Address,
+	walletCreations []*WalletCreation,
+) ([]*ecdsa.PublicKey, error) {
+	missingWallets := make([]*ecdsa.PublicKey, 0)
+
+	for _, walletCreation := range walletCreations {
+		walletPublicKey := walletCreation.WalletPublicKey
+
+		if len(n.walletRegistry.getSigners(walletPublicKey)) > 0 {
+			continue
+		}
+
+		if !isWalletMember(operatorAddress, walletCreation) {
+			continue
+		}
+
+		walletID, err := n.chain.CalculateWalletID(walletPublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("cannot calculate wallet ID: [%v]", err)
+		}
+
+		isRegistered, err := n.chain.IsWalletRegistered(walletID)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"cannot check if wallet [0x%x] is registered: [%v]",
+				walletID,
+				err,
+			)
+		}
+		if !isRegistered {
+			continue
+		}
+
+		missingWallets = append(missingWallets, walletPublicKey)
+	}
+
+	return missingWallets, nil
+}
+
+// isWalletMember returns true if the operator with the given address is
+// a member of the signing group of the created wallet. Members marked as
+// misbehaved in the DKG result do not hold key shares of the wallet so, they
+// are not considered members.
+func isWalletMember(
+	operatorAddress chain.Address,
+	walletCreation *WalletCreation,
+) bool {
+	for i, operator := range walletCreation.Operators {
+		if operator != operatorAddress {
+			continue
+		}
+
+		// The group member index should be in range [1, groupSize] so we
+		// need to add 1.
+		memberIndex := group.MemberIndex(i + 1)
+
+		isMisbehaved := false
+		for _, misbehavedIndex := range walletCreation.MisbehavedMembersIndexes {
+			if misbehavedIndex == memberIndex {
+				isMisbehaved = true
+				break
+			}
+		}
+
+		if !isMisbehaved {
+			return true
+		}
+	}
+
+	return false
+}
+
 // operatorAddress returns the node's operator address.
 func (n *node) operatorAddress() (chain.Address, error) {
 	_, operatorPublicKey, err := n.chain.OperatorKeyPair()
@@ -217,6 +429,84 @@ func (n *node) operatorID() (chain.OperatorID, error) {
 	return operatorID, nil
 }
 
+// NodeStatus describes the data structure exposed by the node through the
+// diagnostics endpoint.
+type NodeStatus struct {
+	// ActiveWallets holds the 20-byte public key hashes of all wallets
+	// controlled by the node, encoded as hex strings.
+	ActiveWallets []string `json:"active_wallets"`
+	// SignerCount is the total number of signers controlled by the node
+	// across all wallets.
+	SignerCount int `json:"signer_count"`
+	// WalletCount is the number of distinct wallets controlled by the node.
+	WalletCount int `json:"wallet_count"`
+	// PreParamsAvailable is the number of ECDSA DKG pre-parameters currently
+	// available in the pool.
+	PreParamsAvailable int `json:"pre_params_available"`
+	// ActiveProtocols is the number of client protocols registered in the
+	// scheduler that are currently executing.
+	ActiveProtocols int `json:"active_protocols"`
+	// DKGRetryAttempts holds the number of attempts made so far by DKGs
+	// currently executed by the node, by DKG seed encoded as a hex string.
+	DKGRetryAttempts map[string]uint `json:"dkg_retry_attempts"`
+	// LastDKGAttempt is the most recent event of a DKG attempt made by
+	// the node. Nil if the node has not made any DKG attempt yet.
+	LastDKGAttempt *DKGAttemptEvent `json:"last_dkg_attempt"`
+	// ActiveGoroutines is the number of goroutines launched by the node
+	// to handle protocol phases that are still running.
+	ActiveGoroutines int `json:"active_goroutines"`
+	// ActiveGoroutinesByPhase holds the number of goroutines launched by
+	// the node that are still running, by protocol phase.
+	ActiveGoroutinesByPhase map[string]int `json:"active_goroutines_by_phase"`
+	// WalletCreationBlocks holds the numbers of blocks at which wallets
+	// controlled by the node were registered on-chain, by 20-byte wallet
+	// public key hash encoded as a hex string. Wallets whose creation blocks
+	// are not known yet are not included.
+	WalletCreationBlocks map[string]uint64 `json:"wallet_creation_blocks"`
+}
+
+// status returns the current status of the node.
+func (n *node) status() *NodeStatus {
+	wallets := n.walletRegistry.ListWallets()
+
+	activeWallets := make([]string, 0, len(wallets))
+	walletCreationBlocks := make(map[string]uint64)
+	for _, wallet := range wallets {
+		walletPublicKeyHash := fmt.Sprintf(
+			"0x%x",
+			bitcoin.PublicKeyHash(wallet.publicKey),
+		)
+		activeWallets = append(activeWallets, walletPublicKeyHash)
+		creationBlock := n.walletRegistry.getWalletCreationBlock(
+			wallet.publicKey,
+		)
+		if creationBlock != 0 {
+			walletCreationBlocks[walletPublicKeyHash] = creationBlock
+		}
+	}
+
+	return &NodeStatus{
+		ActiveWallets:      activeWallets,
+		SignerCount:        n.walletRegistry.Len(),
+		WalletCount:        n.walletRegistry.WalletCount(),
+		PreParamsAvailable: n.dkgExecutor.preParamsCount(),
+		ActiveProtocols:    n.scheduler.ActiveProtocols(),
+		DKGRetryAttempts:   n.dkgExecutor.retryAttempts(),
+		LastDKGAttempt:     n.dkgExecutor.lastAttempt(),
+
+		ActiveGoroutines:        n.ActiveGoroutines(),
+		ActiveGoroutinesByPhase: n.goroutineTracker.countByPhase(),
+
+		WalletCreationBlocks: walletCreationBlocks,
+	}
+}
+
+// ActiveGoroutines returns the number of goroutines launched by the node to
+// handle protocol phases that are still running.
+func (n *node) ActiveGoroutines() int {
+	return n.goroutineTracker.count()
+}
+
 // joinDKGIfEligible takes a seed value and undergoes the process of the
 // distributed key generation if this node's operator proves to be eligible for
 // the group generated by that seed. This is an interactive on-chain process,
@@ -230,9 +520,74 @@ func (n *node) joinDKGIfEligible(
 	startBlock uint64,
 	delayBlocks uint64,
 ) {
+	if !n.chain.IsAvailable() {
+		logger.Warnf(
+			"chain is not available; not joining DKG with seed [0x%x]",
+			seed,
+		)
+		return
+	}
+
 	n.dkgExecutor.executeDkgIfEligible(seed, startBlock, delayBlocks)
 }
 
+// dkgDelayBlocks returns the number of blocks the off-chain DKG started at
+// the given block should be delayed by. The delay covers the DKG start
+// confirmation period and is extended if the beacon is busy generating
+// a relay entry at the moment the DKG would start. The beacon busy period is
+// determined from past relay entry requests so, all members observing the
+// same chain state agree on the delay. The function is called once the DKG
+// start confirmation period elapses, i.e. at the chain tip so, only relay
+// entry requests mined before the confirmation period are taken into account.
+// Requests mined during the confirmation period are not settled yet and
+// members could see them differently.
+func (n *node) dkgDelayBlocks(startBlock uint64) (uint64, error) {
+	delayBlocks := uint64(dkgStartedConfirmationBlocks)
+	protocolStartBlock := startBlock + delayBlocks
+	settledBlock := protocolStartBlock - dkgStartedConfirmationBlocks
+
+	// Only relay entries requested within the beacon busy period before
+	// the protocol start block can keep the beacon busy at that block.
+	filterStartBlock := uint64(0)
+	if protocolStartBlock >= beaconBusyBlocks {
+		filterStartBlock = protocolStartBlock - beaconBusyBlocks + 1
+	}
+
+	// None of the settled relay entry requests can keep the beacon busy
+	// at the protocol start block.
+	if filterStartBlock > settledBlock {
+		return delayBlocks, nil
+	}
+
+	events, err := n.chain.PastRelayEntryRequestedEvents(
+		&BeaconRelayEntryRequestedEventFilter{
+			StartBlock: filterStartBlock,
+			EndBlock:   &settledBlock,
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"cannot get past relay entry requested events: [%v]",
+			err,
+		)
+	}
+
+	if len(events) == 0 {
+		return delayBlocks, nil
+	}
+
+	busyUntilBlock := events[len(events)-1].BlockNumber + beaconBusyBlocks
+
+	logger.Infof(
+		"beacon is busy generating a relay entry until block [%v]; "+
+			"delaying DKG started at block [%v]",
+		busyUntilBlock,
+		startBlock,
+	)
+
+	return busyUntilBlock - startBlock, nil
+}
+
 // validateDKG performs the submitted DKG result validation process.
 // If the result is not valid, this function submits an on-chain result
 // challenge. If the result is valid and the given node was involved in the DKG,
@@ -337,6 +692,7 @@ func (n *node) getSigningExecutor(
 		blockCounter.CurrentBlock,
 		n.waitForBlockHeight,
 		signingAttemptsLimit,
+		n.signingRateLimit,
 	)
 
 	n.signingExecutors[executorKey] = executor
@@ -344,6 +700,20 @@ func (n *node) getSigningExecutor(
 	return executor, true, nil
 }
 
+// signingRateLimitedTotal returns the total number of signing requests
+// rejected by the rate limiters of all signing executors of this node.
+func (n *node) signingRateLimitedTotal() uint64 {
+	n.signingExecutorsMutex.Lock()
+	defer n.signingExecutorsMutex.Unlock()
+
+	total := uint64(0)
+	for _, executor := range n.signingExecutors {
+		total += executor.rateLimitedRequestsTotal()
+	}
+
+	return total
+}
+
 // getCoordinationExecutor gets the coordination executor responsible for
 // executing coordination related to a specific wallet whose part is controlled
 // by this node. The second boolean return value indicates whether the node
@@ -590,6 +960,18 @@ func (n *node) handleHeartbeatProposal(
 		zap.Uint64("startBlock", startBlock),
 		zap.Uint64("expiryBlock", expiryBlock),
 	)
+	// The heartbeat message must be persisted before it gets signed.
+	// Otherwise, a fraud challenge submitted against the signature could
+	// not be defeated.
+	err = n.walletRegistry.saveHeartbeatMessage(
+		wallet.publicKey,
+		proposal.Message,
+	)
+	if err != nil {
+		walletActionLogger.Errorf("cannot save heartbeat message: [%v]", err)
+		return
+	}
+
 	walletActionLogger.Infof("dispatching wallet action")
 
 	action := newHeartbeatAction(
@@ -797,7 +1179,12 @@ func (n *node) handleMovingFundsProposal(
 	)
 	walletActionLogger.Infof("dispatching wallet action")
 
+	movingFundsCtx, cancelMovingFundsCtx := context.WithCancel(
+		context.Background(),
+	)
+
 	action := newMovingFundsAction(
+		movingFundsCtx,
 		walletActionLogger,
 		n.chain,
 		n.btcChain,
@@ -809,8 +1196,15 @@ func (n *node) handleMovingFundsProposal(
 		n.waitForBlockHeight,
 	)
 
-	err = n.walletDispatcher.dispatch(action)
+	err = n.walletDispatcher.dispatch(
+		&cancelableMovingFundsAction{
+			walletAction: action,
+			node:         n,
+			cancelFn:     cancelMovingFundsCtx,
+		},
+	)
 	if err != nil {
+		cancelMovingFundsCtx()
 		walletActionLogger.Errorf("cannot dispatch wallet action: [%v]", err)
 		return
 	}
@@ -818,6 +1212,207 @@ func (n *node) handleMovingFundsProposal(
 	walletActionLogger.Infof("wallet action dispatched successfully")
 }
 
+// cancelableMovingFundsAction wraps a moving funds action so the node tracks
+// the function cancelling the action only while the action is executed.
+type cancelableMovingFundsAction struct {
+	walletAction
+
+	node     *node
+	cancelFn context.CancelFunc
+}
+
+func (cmfa *cancelableMovingFundsAction) execute() error {
+	walletPublicKeyHash := bitcoin.PublicKeyHash(cmfa.wallet().publicKey)
+
+	cmfa.node.setMovingFundsCancel(walletPublicKeyHash, cmfa.cancelFn)
+	defer cmfa.node.removeMovingFundsCancel(walletPublicKeyHash)
+
+	return cmfa.walletAction.execute()
+}
+
+// setMovingFundsCancel stores the function cancelling the moving funds action
+// of the given wallet. The function stored previously for the same wallet,
+// if any, is called as its action is no longer tracked.
+func (n *node) setMovingFundsCancel(
+	walletPublicKeyHash [20]byte,
+	cancelFn context.CancelFunc,
+) {
+	n.movingFundsCancelsMutex.Lock()
+	defer n.movingFundsCancelsMutex.Unlock()
+
+	if previousCancelFn, ok := n.movingFundsCancels[walletPublicKeyHash]; ok {
+		previousCancelFn()
+	}
+
+	n.movingFundsCancels[walletPublicKeyHash] = cancelFn
+}
+
+// removeMovingFundsCancel removes the function cancelling the moving funds
+// action of the given wallet and calls it to release the action's context.
+// The function is no-op if no function is stored for the given wallet.
+func (n *node) removeMovingFundsCancel(walletPublicKeyHash [20]byte) {
+	n.movingFundsCancelsMutex.Lock()
+	defer n.movingFundsCancelsMutex.Unlock()
+
+	if cancelFn, ok := n.movingFundsCancels[walletPublicKeyHash]; ok {
+		cancelFn()
+		delete(n.movingFundsCancels, walletPublicKeyHash)
+	}
+}
+
+// handleMovingFundsTimedOut handles an on-chain notification about a wallet
+// that has not moved its funds within the protocol timeout. The pending
+// moving funds action of the wallet, if any, is cancelled as the chain no
+// longer accepts its outcome.
+func (n *node) handleMovingFundsTimedOut(event *MovingFundsTimedOutEvent) {
+	movingFundsLogger := logger.With(
+		zap.String("walletPKH", fmt.Sprintf("0x%x", event.WalletPublicKeyHash)),
+		zap.Uint64("blockNumber", event.BlockNumber),
+	)
+
+	n.movingFundsCancelsMutex.Lock()
+	cancelFn, ok := n.movingFundsCancels[event.WalletPublicKeyHash]
+	delete(n.movingFundsCancels, event.WalletPublicKeyHash)
+	n.movingFundsCancelsMutex.Unlock()
+
+	if !ok {
+		movingFundsLogger.Infof(
+			"moving funds timed out; no pending moving funds action",
+		)
+		return
+	}
+
+	cancelFn()
+
+	movingFundsLogger.Warnf(
+		"moving funds timed out; pending moving funds action cancelled",
+	)
+}
+
+// handleDKGTimedOut handles an on-chain notification about a DKG that has
+// not produced an approved result within the protocol timeout. The timeout
+// is reported with a warning log containing the DKG details.
+func (n *node) handleDKGTimedOut(event *DKGTimedOutEvent) {
+	dkgLogger := logger.With(
+		zap.String("seed", fmt.Sprintf("0x%x", event.Seed)),
+		zap.Uint64("startBlock", event.StartBlock),
+		zap.Uint64("timeoutBlock", event.BlockNumber),
+	)
+
+	dkgLogger.Warnf("DKG timed out without an approved result")
+}
+
+// handleFraudChallengeSubmitted handles an on-chain notification about a fraud
+// challenge submitted against a wallet. If the node controls signers of the
+// challenged wallet and the challenged sighash belongs to a heartbeat message
+// the node was asked to sign, the challenge is defeated by revealing that
+// message. All wallet members get the same notification so, the submission
+// is delayed based on the lowest member index controlled by the node and
+// skipped if another member defeated the challenge in the meantime.
+// Challenges against signatures of Bitcoin transactions cannot be defeated
+// this way and are only reported.
+func (n *node) handleFraudChallengeSubmitted(
+	ctx context.Context,
+	event *FraudChallengeSubmittedEvent,
+) {
+	fraudLogger := logger.With(
+		zap.String(
+			"walletPublicKeyHash",
+			fmt.Sprintf("0x%x", event.WalletPublicKeyHash),
+		),
+		zap.String("sighash", fmt.Sprintf("0x%x", event.Sighash)),
+		zap.Uint64("challengeBlock", event.BlockNumber),
+	)
+
+	wallet, ok := n.walletRegistry.getWalletByPublicKeyHash(
+		event.WalletPublicKeyHash,
+	)
+	if !ok {
+		fraudLogger.Infof(
+			"node does not control signers of the challenged wallet; " +
+				"ignoring the fraud challenge",
+		)
+		return
+	}
+
+	message, ok := n.walletRegistry.getHeartbeatMessage(
+		wallet.publicKey,
+		event.Sighash,
+	)
+	if !ok {
+		fraudLogger.Warnf(
+			"fraud challenge submitted against a signature that is not " +
+				"a known heartbeat; the challenge cannot be defeated " +
+				"automatically",
+		)
+		return
+	}
+
+	memberIndex := group.MemberIndex(0)
+	for _, signer := range n.walletRegistry.getSigners(wallet.publicKey) {
+		if memberIndex == 0 || signer.signingGroupMemberIndex < memberIndex {
+			memberIndex = signer.signingGroupMemberIndex
+		}
+	}
+
+	currentBlock, err := getCurrentBlock(n.chain)
+	if err != nil {
+		fraudLogger.Errorf("cannot get current block: [%v]", err)
+		return
+	}
+
+	delayBlocks := uint64(memberIndex-1) * fraudChallengeDefeatDelayStepBlocks
+	submissionBlock := currentBlock + delayBlocks
+
+	fraudLogger.Infof(
+		"[member:%v] waiting for block [%v] to defeat fraud challenge",
+		memberIndex,
+		submissionBlock,
+	)
+
+	err = n.waitForBlockHeight(ctx, submissionBlock)
+	if err != nil {
+		fraudLogger.Errorf(
+			"error while waiting for fraud challenge defeat block: [%v]",
+			err,
+		)
+		return
+	}
+
+	isResolved, err := n.chain.IsFraudChallengeResolved(
+		wallet.publicKey,
+		event.Sighash,
+	)
+	if err != nil {
+		fraudLogger.Errorf("cannot check fraud challenge state: [%v]", err)
+		return
+	}
+	if isResolved {
+		fraudLogger.Infof(
+			"[member:%v] fraud challenge already resolved; "+
+				"aborting fraud challenge defeat submission",
+			memberIndex,
+		)
+		return
+	}
+
+	fraudLogger.Infof(
+		"[member:%v] defeating fraud challenge with heartbeat message",
+		memberIndex,
+	)
+
+	err = n.chain.SubmitFraudChallengeDefeatWithHeartbeat(
+		wallet.publicKey,
+		message[:],
+	)
+	if err != nil {
+		fraudLogger.Errorf("cannot defeat fraud challenge: [%v]", err)
+		return
+	}
+
+	fraudLogger.Infof("fraud challenge defeated successfully")
+}
+
 // handleMovedFundsSweepProposal handles an incoming moved funds sweep proposal
 // by orchestrating and dispatching an appropriate wallet action.
 func (n *node) handleMovedFundsSweepProposal(
@@ -1147,6 +1742,43 @@ func (n *node) archiveClosedWallets() error {
 	return nil
 }
 
+// periodicSignerHealthCheck periodically verifies the persisted key shares
+// of the node's signers match their in-memory copies, until the given
+// context is done.
+func (n *node) periodicSignerHealthCheck(ctx context.Context) {
+	ticker := time.NewTicker(n.signerHealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n.checkSignerHealth()
+		}
+	}
+}
+
+// checkSignerHealth verifies the persisted key shares of the node's signers
+// match their in-memory copies and logs an error for each signer whose
+// persisted key share is missing or diverges.
+func (n *node) checkSignerHealth() {
+	corruptedSigners := n.walletRegistry.findCorruptedSigners()
+
+	for _, signer := range corruptedSigners {
+		logger.Errorf(
+			"CRITICAL: persisted key share of signer with index [%v] of "+
+				"wallet [0x%x] is missing or does not match the in-memory copy",
+			signer.signingGroupMemberIndex,
+			bitcoin.PublicKeyHash(signer.wallet.publicKey),
+		)
+	}
+
+	if len(corruptedSigners) == 0 {
+		logger.Infof("persisted key shares of all signers are healthy")
+	}
+}
+
 // handleWalletClosure handles the wallet termination or closing process.
 func (n *node) handleWalletClosure(walletID [32]byte) error {
 	blockCounter, err := n.chain.BlockCounter()
@@ -1226,6 +1858,11 @@ type waitForBlockFn func(context.Context, uint64) error
 // getCurrentBlockFn represents a function returning the current block height.
 type getCurrentBlockFn func() (uint64, error)
 
+// errNilBlockHeightWaiter is returned by waitForBlockHeight when the block
+// counter returns a nil block height waiter channel. Waiting on such a
+// channel would block until the context is done which may never happen.
+var errNilBlockHeightWaiter = fmt.Errorf("block height waiter is nil")
+
 // TODO: this should become a part of BlockHeightWaiter interface.
 func (n *node) waitForBlockHeight(ctx context.Context, blockHeight uint64) error {
 	blockCounter, err := n.chain.BlockCounter()
@@ -1238,6 +1875,10 @@ func (n *node) waitForBlockHeight(ctx context.Context, blockHeight uint64) error
 		return err
 	}
 
+	if wait == nil {
+		return errNilBlockHeightWaiter
+	}
+
 	select {
 	case <-wait:
 	case <-ctx.Done():
@@ -4,7 +4,9 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
@@ -116,7 +118,7 @@ func TestNode_GetSigningExecutor(t *testing.T) {
 		executor.broadcastChannel.Name(),
 	)
 
-	_, ok, err = node.getSigningExecutor(walletPublicKey)
+	cachedExecutor, ok, err := node.getSigningExecutor(walletPublicKey)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -133,6 +135,12 @@ func TestNode_GetSigningExecutor(t *testing.T) {
 		len(node.signingExecutors),
 	)
 
+	// All signing sessions of the wallet must use the same broadcast
+	// channel so channel handles do not accumulate across sessions.
+	if cachedExecutor.broadcastChannel != executor.broadcastChannel {
+		t.Errorf("cached executor uses a different broadcast channel")
+	}
+
 	// Construct an arbitrary public key representing a wallet that is not
 	// controlled by the node. We need to make sure the public key's points
 	// are on the curve to avoid troubles during processing.
@@ -152,6 +160,564 @@ func TestNode_GetSigningExecutor(t *testing.T) {
 	}
 }
 
+func TestNode_FindWalletsWithoutSigners(t *testing.T) {
+	groupParameters := &GroupParameters{
+		GroupSize:       5,
+		GroupQuorum:     4,
+		HonestThreshold: 3,
+	}
+
+	localChain := Connect()
+	localProvider := local.Connect()
+
+	signer := createMockSigner(t)
+
+	walletPublicKeyHash := bitcoin.PublicKeyHash(signer.wallet.publicKey)
+	walletID, err := localChain.CalculateWalletID(signer.wallet.publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	localChain.setWallet(
+		walletPublicKeyHash,
+		&WalletChainData{
+			EcdsaWalletID: walletID,
+			State:         StateLive,
+		},
+	)
+
+	// Populate the mock keystore with the mock signer's data. This is
+	// required to make the node controlling the signer's wallet.
+	keyStorePersistence := createMockKeyStorePersistence(t, signer)
+
+	node, err := newNode(
+		groupParameters,
+		localChain,
+		newLocalBitcoinChain(),
+		localProvider,
+		keyStorePersistence,
+		&mockPersistenceHandle{},
+		generator.StartScheduler(),
+		&mockCoordinationProposalGenerator{},
+		Config{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	operatorAddress, err := node.operatorAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	walletPublicKey := signer.wallet.publicKey
+
+	// Construct an arbitrary public key representing a wallet that is not
+	// controlled by the node. We need to make sure the public key's points
+	// are on the curve to avoid troubles during processing.
+	x, y := walletPublicKey.Curve.Double(walletPublicKey.X, walletPublicKey.Y)
+	missingWalletPublicKey := &ecdsa.PublicKey{
+		Curve: walletPublicKey.Curve,
+		X:     x,
+		Y:     y,
+	}
+
+	missingWalletID, err := localChain.CalculateWalletID(missingWalletPublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	localChain.setWallet(
+		bitcoin.PublicKeyHash(missingWalletPublicKey),
+		&WalletChainData{
+			EcdsaWalletID: missingWalletID,
+			State:         StateLive,
+		},
+	)
+
+	otherOperatorAddress := chain.Address("0xAA")
+
+	walletCreation := &WalletCreation{
+		WalletPublicKey: walletPublicKey,
+		Operators:       chain.Addresses{operatorAddress, otherOperatorAddress},
+	}
+
+	var tests = map[string]struct {
+		walletCreations        []*WalletCreation
+		expectedMissingWallets []*ecdsa.PublicKey
+	}{
+		"no on-chain wallets": {
+			walletCreations:        nil,
+			expectedMissingWallets: []*ecdsa.PublicKey{},
+		},
+		"all on-chain wallets have signers": {
+			walletCreations:        []*WalletCreation{walletCreation},
+			expectedMissingWallets: []*ecdsa.PublicKey{},
+		},
+		"on-chain wallet without signers": {
+			walletCreations: []*WalletCreation{
+				walletCreation,
+				{
+					WalletPublicKey: missingWalletPublicKey,
+					Operators: chain.Addresses{
+						otherOperatorAddress,
+						operatorAddress,
+					},
+				},
+			},
+			expectedMissingWallets: []*ecdsa.PublicKey{missingWalletPublicKey},
+		},
+		"on-chain wallet without signers the operator is not member of": {
+			walletCreations: []*WalletCreation{
+				walletCreation,
+				{
+					WalletPublicKey: missingWalletPublicKey,
+					Operators: chain.Addresses{
+						otherOperatorAddress,
+						otherOperatorAddress,
+					},
+				},
+			},
+			expectedMissingWallets: []*ecdsa.PublicKey{},
+		},
+		"on-chain wallet without signers the operator misbehaved in": {
+			walletCreations: []*WalletCreation{
+				walletCreation,
+				{
+					WalletPublicKey: missingWalletPublicKey,
+					Operators: chain.Addresses{
+						otherOperatorAddress,
+						operatorAddress,
+					},
+					MisbehavedMembersIndexes: []group.MemberIndex{2},
+				},
+			},
+			expectedMissingWallets: []*ecdsa.PublicKey{},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			missingWallets, err := node.findWalletsWithoutSigners(
+				operatorAddress,
+				test.walletCreations,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(test.expectedMissingWallets, missingWallets) {
+				t.Errorf(
+					"unexpected missing wallets\nexpected: %v\nactual:   %v",
+					test.expectedMissingWallets,
+					missingWallets,
+				)
+			}
+		})
+	}
+}
+
+func TestNode_WaitForBlockHeight_NilWaiter(t *testing.T) {
+	localChain := Connect()
+	localChain.blockCounter = &nilWaiterBlockCounter{localChain.blockCounter}
+
+	node := &node{chain: localChain}
+
+	errChan := make(chan error, 1)
+	go func() {
+		// Use a context without deadline to make sure the function does not
+		// rely on the context to return.
+		errChan <- node.waitForBlockHeight(context.Background(), 100)
+	}()
+
+	select {
+	case err := <-errChan:
+		if !errors.Is(err, errNilBlockHeightWaiter) {
+			t.Errorf(
+				"unexpected error\n"+
+					"expected: [%v]\n"+
+					"actual:   [%v]",
+				errNilBlockHeightWaiter,
+				err,
+			)
+		}
+	case <-time.After(1 * time.Second):
+		t.Fatal("waiting for block height did not return")
+	}
+}
+
+// nilWaiterBlockCounter is a block counter returning nil block height
+// waiters.
+type nilWaiterBlockCounter struct {
+	chain.BlockCounter
+}
+
+func (nwbc *nilWaiterBlockCounter) BlockHeightWaiter(
+	blockNumber uint64,
+) (<-chan uint64, error) {
+	return nil, nil
+}
+
+func TestNode_DkgDelayBlocks(t *testing.T) {
+	var tests = map[string]struct {
+		relayEntryRequestBlock *uint64
+		dkgStartBlock          uint64
+		expectedDelayBlocks    uint64
+	}{
+		"no relay entry requested": {
+			dkgStartBlock:       1000,
+			expectedDelayBlocks: dkgStartedConfirmationBlocks,
+		},
+		"beacon no longer busy when DKG would start": {
+			relayEntryRequestBlock: uint64Ptr(995),
+			dkgStartBlock:          1000,
+			// The beacon is busy until block 995 + 20 = 1015 while the DKG
+			// would start at block 1000 + 20 = 1020 so no extra delay.
+			expectedDelayBlocks: dkgStartedConfirmationBlocks,
+		},
+		"relay entry requested at DKG start": {
+			relayEntryRequestBlock: uint64Ptr(1000),
+			dkgStartBlock:          1000,
+			// The beacon is busy until block 1000 + 20 = 1020 which is
+			// exactly when the DKG would start so no extra delay.
+			expectedDelayBlocks: dkgStartedConfirmationBlocks,
+		},
+		"relay entry requested during DKG confirmation": {
+			relayEntryRequestBlock: uint64Ptr(1010),
+			dkgStartBlock:          1000,
+			// The relay entry request is not settled yet so, it is not
+			// taken into account.
+			expectedDelayBlocks: dkgStartedConfirmationBlocks,
+		},
+		"relay entry requested at DKG confirmation end": {
+			relayEntryRequestBlock: uint64Ptr(1020),
+			dkgStartBlock:          1000,
+			// The relay entry request is not settled yet so, it is not
+			// taken into account.
+			expectedDelayBlocks: dkgStartedConfirmationBlocks,
+		},
+		"relay entry requested after DKG would start": {
+			relayEntryRequestBlock: uint64Ptr(1021),
+			dkgStartBlock:          1000,
+			// The relay entry is requested once the DKG has already started.
+			expectedDelayBlocks: dkgStartedConfirmationBlocks,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			localChain := Connect()
+
+			if test.relayEntryRequestBlock != nil {
+				localChain.addRelayEntryRequestedEvent(
+					&BeaconRelayEntryRequestedEvent{
+						BlockNumber: *test.relayEntryRequestBlock,
+					},
+				)
+			}
+
+			node := &node{chain: localChain}
+
+			delayBlocks, err := node.dkgDelayBlocks(test.dkgStartBlock)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			testutils.AssertUintsEqual(
+				t,
+				"delay blocks",
+				test.expectedDelayBlocks,
+				delayBlocks,
+			)
+		})
+	}
+}
+
+func uint64Ptr(value uint64) *uint64 {
+	return &value
+}
+
+func TestNode_JoinDKGIfEligible_ChainAvailability(t *testing.T) {
+	var tests = map[string]struct {
+		chainAvailable           bool
+		expectedSelectGroupCalls int
+	}{
+		"chain available": {
+			chainAvailable:           true,
+			expectedSelectGroupCalls: 1,
+		},
+		"chain not available": {
+			chainAvailable:           false,
+			expectedSelectGroupCalls: 0,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			localChain := Connect()
+			localChain.setAvailable(test.chainAvailable)
+
+			node := &node{
+				chain: localChain,
+				dkgExecutor: &dkgExecutor{
+					chain: localChain,
+				},
+			}
+
+			// The local chain does not support group selection so, the
+			// execution stops right after the group selection attempt.
+			node.joinDKGIfEligible(big.NewInt(100), 10, 0)
+
+			localChain.selectGroupCallsMutex.Lock()
+			defer localChain.selectGroupCallsMutex.Unlock()
+
+			testutils.AssertIntsEqual(
+				t,
+				"group selection calls",
+				test.expectedSelectGroupCalls,
+				localChain.selectGroupCalls,
+			)
+		})
+	}
+}
+
+func TestNode_RestoreSigningGroupOperators(t *testing.T) {
+	groupParameters := &GroupParameters{
+		GroupSize:       5,
+		GroupQuorum:     4,
+		HonestThreshold: 3,
+	}
+
+	localChain := Connect()
+	localProvider := local.Connect()
+
+	signer := createMockSigner(t)
+	signingGroupOperators := signer.wallet.signingGroupOperators
+
+	// Simulate a signer persisted without the signing group operators.
+	signer.wallet.signingGroupOperators = nil
+
+	walletPublicKey := signer.wallet.publicKey
+
+	walletID, err := localChain.CalculateWalletID(walletPublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	localChain.setWallet(
+		bitcoin.PublicKeyHash(walletPublicKey),
+		&WalletChainData{
+			EcdsaWalletID: walletID,
+			State:         StateLive,
+		},
+	)
+	localChain.addWalletCreation(&WalletCreation{
+		WalletPublicKey: walletPublicKey,
+		Operators:       signingGroupOperators,
+		BlockNumber:     1500,
+	})
+
+	keyStorePersistence := createMockKeyStorePersistence(t, signer)
+
+	node, err := newNode(
+		groupParameters,
+		localChain,
+		newLocalBitcoinChain(),
+		localProvider,
+		keyStorePersistence,
+		&mockPersistenceHandle{},
+		generator.StartScheduler(),
+		&mockCoordinationProposalGenerator{},
+		Config{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signers := node.walletRegistry.getSigners(walletPublicKey)
+
+	testutils.AssertIntsEqual(t, "signers count", 1, len(signers))
+
+	// Signing group operators must not be restored on the startup path.
+	testutils.AssertIntsEqual(
+		t,
+		"signing group operators count before restoration",
+		0,
+		len(signers[0].wallet.signingGroupOperators),
+	)
+
+	err = node.syncWalletCreations(0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Signers handed out before the restoration must stay untouched.
+	testutils.AssertIntsEqual(
+		t,
+		"signing group operators count of previously obtained signer",
+		0,
+		len(signers[0].wallet.signingGroupOperators),
+	)
+
+	restoredSigners := node.walletRegistry.getSigners(walletPublicKey)
+
+	testutils.AssertIntsEqual(
+		t,
+		"restored signers count",
+		1,
+		len(restoredSigners),
+	)
+
+	if !reflect.DeepEqual(
+		signingGroupOperators,
+		restoredSigners[0].wallet.signingGroupOperators,
+	) {
+		t.Errorf(
+			"unexpected signing group operators\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			signingGroupOperators,
+			restoredSigners[0].wallet.signingGroupOperators,
+		)
+	}
+}
+
+func TestNode_SyncWalletCreations(t *testing.T) {
+	groupParameters := &GroupParameters{
+		GroupSize:       5,
+		GroupQuorum:     4,
+		HonestThreshold: 3,
+	}
+
+	localChain := Connect()
+	localProvider := local.Connect()
+
+	signer := createMockSigner(t)
+	walletPublicKey := signer.wallet.publicKey
+	walletPublicKeyHash := fmt.Sprintf(
+		"0x%x",
+		bitcoin.PublicKeyHash(walletPublicKey),
+	)
+
+	walletID, err := localChain.CalculateWalletID(walletPublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	localChain.setWallet(
+		bitcoin.PublicKeyHash(walletPublicKey),
+		&WalletChainData{
+			EcdsaWalletID: walletID,
+			State:         StateLive,
+		},
+	)
+
+	keyStorePersistence := createMockKeyStorePersistence(t, signer)
+
+	node, err := newNode(
+		groupParameters,
+		localChain,
+		newLocalBitcoinChain(),
+		localProvider,
+		keyStorePersistence,
+		&mockPersistenceHandle{},
+		generator.StartScheduler(),
+		&mockCoordinationProposalGenerator{},
+		Config{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wallets := node.walletRegistry.ListWallets()
+	testutils.AssertIntsEqual(t, "wallets count", 1, len(wallets))
+	testutils.AssertUintsEqual(
+		t,
+		"creation block before resolution",
+		0,
+		node.walletRegistry.getWalletCreationBlock(walletPublicKey),
+	)
+	testutils.AssertIntsEqual(
+		t,
+		"status wallet creation blocks count",
+		0,
+		len(node.status().WalletCreationBlocks),
+	)
+
+	// The wallet creation is not known by the chain yet so, the node
+	// should not be able to resolve its block.
+	err = node.syncWalletCreations(0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutils.AssertUintsEqual(
+		t,
+		"creation block after failed resolution",
+		0,
+		node.walletRegistry.getWalletCreationBlock(walletPublicKey),
+	)
+
+	localChain.addWalletCreation(&WalletCreation{
+		WalletPublicKey: walletPublicKey,
+		BlockNumber:     1500,
+	})
+
+	// The node status must not resolve creation blocks on its own.
+	testutils.AssertIntsEqual(
+		t,
+		"status wallet creation blocks count before resolution",
+		0,
+		len(node.status().WalletCreationBlocks),
+	)
+
+	// The wallet creation is out of the scanned range.
+	endBlock := uint64(1499)
+	err = node.syncWalletCreations(0, &endBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutils.AssertUintsEqual(
+		t,
+		"creation block after out of range resolution",
+		0,
+		node.walletRegistry.getWalletCreationBlock(walletPublicKey),
+	)
+
+	err = node.syncWalletCreations(1000, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	status := node.status()
+
+	wallets = node.walletRegistry.ListWallets()
+	testutils.AssertIntsEqual(t, "wallets count", 1, len(wallets))
+	testutils.AssertUintsEqual(
+		t,
+		"creation block after resolution",
+		1500,
+		node.walletRegistry.getWalletCreationBlock(walletPublicKey),
+	)
+
+	expectedWalletCreationBlocks := map[string]uint64{
+		walletPublicKeyHash: 1500,
+	}
+	if !reflect.DeepEqual(
+		expectedWalletCreationBlocks,
+		status.WalletCreationBlocks,
+	) {
+		t.Errorf(
+			"unexpected wallet creation blocks\n"+
+				"expected: [%v]\n"+
+				"actual:   [%v]",
+			expectedWalletCreationBlocks,
+			status.WalletCreationBlocks,
+		)
+	}
+}
+
 func TestNode_GetCoordinationExecutor(t *testing.T) {
 	groupParameters := &GroupParameters{
 		GroupSize:       5,
@@ -445,6 +1011,100 @@ loop:
 	)
 }
 
+func TestNode_HandleMovingFundsTimedOut(t *testing.T) {
+	node, _, walletPublicKey := setupSigningNode(t)
+
+	walletPublicKeyHash := bitcoin.PublicKeyHash(walletPublicKey)
+
+	previousCtx, cancelPreviousCtx := context.WithCancel(context.Background())
+	defer cancelPreviousCtx()
+	node.setMovingFundsCancel(walletPublicKeyHash, cancelPreviousCtx)
+
+	// Replacing the cancel function must cancel the previous action.
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+	node.setMovingFundsCancel(walletPublicKeyHash, cancelCtx)
+
+	if previousCtx.Err() == nil {
+		t.Fatal("previous moving funds action should be cancelled")
+	}
+	if ctx.Err() != nil {
+		t.Fatal("current moving funds action should not be cancelled yet")
+	}
+
+	event := &MovingFundsTimedOutEvent{
+		WalletPublicKeyHash: walletPublicKeyHash,
+		BlockNumber:         100,
+	}
+
+	node.handleMovingFundsTimedOut(event)
+
+	if ctx.Err() == nil {
+		t.Fatal("moving funds action should be cancelled")
+	}
+
+	node.movingFundsCancelsMutex.Lock()
+	_, ok := node.movingFundsCancels[walletPublicKeyHash]
+	node.movingFundsCancelsMutex.Unlock()
+
+	testutils.AssertBoolsEqual(t, "pending moving funds tracked", false, ok)
+
+	// Handling the timeout again must be a no-op as there is nothing
+	// pending anymore.
+	node.handleMovingFundsTimedOut(event)
+}
+
+func TestNode_CancelableMovingFundsAction(t *testing.T) {
+	node, _, walletPublicKey := setupSigningNode(t)
+
+	walletPublicKeyHash := bitcoin.PublicKeyHash(walletPublicKey)
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	action := &cancelableMovingFundsAction{
+		walletAction: &mockWalletAction{
+			executeFn: func() error {
+				node.movingFundsCancelsMutex.Lock()
+				_, ok := node.movingFundsCancels[walletPublicKeyHash]
+				node.movingFundsCancelsMutex.Unlock()
+
+				testutils.AssertBoolsEqual(
+					t,
+					"moving funds action tracked during execution",
+					true,
+					ok,
+				)
+
+				return nil
+			},
+			actionWallet: wallet{publicKey: walletPublicKey},
+		},
+		node:     node,
+		cancelFn: cancelCtx,
+	}
+
+	err := action.execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ctx.Err() == nil {
+		t.Fatal("moving funds action context should be cancelled")
+	}
+
+	node.movingFundsCancelsMutex.Lock()
+	_, ok := node.movingFundsCancels[walletPublicKeyHash]
+	node.movingFundsCancelsMutex.Unlock()
+
+	testutils.AssertBoolsEqual(
+		t,
+		"moving funds action tracked after execution",
+		false,
+		ok,
+	)
+}
+
 type mockCoordinationProposal struct {
 	action WalletActionType
 }
@@ -538,3 +1198,128 @@ func createMockKeyStorePersistence(
 		saved: descriptors,
 	}
 }
+
+func TestNode_HandleFraudChallengeSubmitted(t *testing.T) {
+	heartbeatMessage := [16]byte{
+		0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+	}
+	heartbeatSighash := bitcoin.ComputeHash(heartbeatMessage[:])
+
+	var tests = map[string]struct {
+		controlledWallet    bool
+		alreadyResolved     bool
+		restarted           bool
+		sighash             [32]byte
+		expectedSubmissions int
+	}{
+		"heartbeat signature of a controlled wallet": {
+			controlledWallet:    true,
+			sighash:             heartbeatSighash,
+			expectedSubmissions: 1,
+		},
+		"heartbeat signature of a controlled wallet already defeated": {
+			controlledWallet:    true,
+			alreadyResolved:     true,
+			sighash:             heartbeatSighash,
+			expectedSubmissions: 1,
+		},
+		// Heartbeat messages are kept for the wallet's lifetime so, the
+		// challenge can be defeated no matter how long ago the message was
+		// signed, including signatures produced before the node restart.
+		"heartbeat signature of a controlled wallet signed before restart": {
+			controlledWallet:    true,
+			restarted:           true,
+			sighash:             heartbeatSighash,
+			expectedSubmissions: 1,
+		},
+		"unknown signature of a controlled wallet": {
+			controlledWallet:    true,
+			sighash:             [32]byte{0x01},
+			expectedSubmissions: 0,
+		},
+		"heartbeat signature of a wallet not controlled by the node": {
+			controlledWallet:    false,
+			sighash:             heartbeatSighash,
+			expectedSubmissions: 0,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			node, localChain, walletPublicKey := setupSigningNode(t)
+
+			err := node.walletRegistry.saveHeartbeatMessage(
+				walletPublicKey,
+				heartbeatMessage,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.restarted {
+				// Simulate the node restart by loading the wallet registry
+				// from the persistence layer again.
+				node.walletRegistry, err = newWalletRegistry(
+					node.walletRegistry.walletStorage.persistence,
+					localChain.CalculateWalletID,
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			walletPublicKeyHash := [20]byte{0xff}
+			if test.controlledWallet {
+				walletPublicKeyHash = bitcoin.PublicKeyHash(walletPublicKey)
+			}
+
+			if test.alreadyResolved {
+				err := localChain.SubmitFraudChallengeDefeatWithHeartbeat(
+					walletPublicKey,
+					heartbeatMessage[:],
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			ctx, cancelCtx := context.WithCancel(context.Background())
+			defer cancelCtx()
+
+			node.handleFraudChallengeSubmitted(
+				ctx,
+				&FraudChallengeSubmittedEvent{
+					WalletPublicKeyHash: walletPublicKeyHash,
+					Sighash:             test.sighash,
+					BlockNumber:         100,
+				},
+			)
+
+			localChain.fraudChallengeDefeatSubmissionsMutex.Lock()
+			defer localChain.fraudChallengeDefeatSubmissionsMutex.Unlock()
+
+			submissions := localChain.fraudChallengeDefeatSubmissions
+
+			testutils.AssertIntsEqual(
+				t,
+				"fraud challenge defeat submissions count",
+				test.expectedSubmissions,
+				len(submissions),
+			)
+
+			if test.expectedSubmissions == 0 {
+				return
+			}
+
+			if !submissions[0].walletPublicKey.Equal(walletPublicKey) {
+				t.Errorf("unexpected wallet public key")
+			}
+			testutils.AssertBytesEqual(
+				t,
+				heartbeatMessage[:],
+				submissions[0].heartbeatMessage,
+			)
+		})
+	}
+}
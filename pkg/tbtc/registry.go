@@ -5,13 +5,22 @@ import (
 	"crypto/elliptic"
 	"encoding/hex"
 	"fmt"
+	"reflect"
+	"strings"
 	"sync"
 
 	"github.com/keep-network/keep-core/pkg/bitcoin"
+	"github.com/keep-network/keep-core/pkg/chain"
+	"github.com/keep-network/keep-core/pkg/protocol/group"
 
 	"github.com/keep-network/keep-common/pkg/persistence"
 )
 
+// heartbeatMessageFilePrefix is the prefix of names of files holding heartbeat
+// messages signed by the wallet. The files are stored next to the wallet
+// signers so they are kept for the wallet's lifetime.
+const heartbeatMessageFilePrefix = "heartbeat_"
+
 // CalculateWalletIDFunc calculates the ECDSA wallet ID based on the provided
 // wallet public key.
 type CalculateWalletIdFunc func(walletPublicKey *ecdsa.PublicKey) ([32]byte, error)
@@ -20,8 +29,9 @@ type CalculateWalletIdFunc func(walletPublicKey *ecdsa.PublicKey) ([32]byte, err
 // by the given node. All functions of the registry are safe for concurrent use.
 type walletRegistry struct {
 	// mutex is a single struct-wide lock that ensures all functions
-	// of the registry are thread-safe.
-	mutex sync.Mutex
+	// of the registry are thread-safe. Read-only functions take the read
+	// lock so they can run concurrently with each other.
+	mutex sync.RWMutex
 
 	// walletCache is a cache of maintained wallets. The cache's key is the
 	// uncompressed public key of the given wallet.
@@ -45,6 +55,29 @@ type walletCacheValue struct {
 	walletID [32]byte
 	// Array of wallet signers controlled by this node.
 	signers []*signer
+	// signersByIndex holds the wallet signers controlled by this node by
+	// their signing group member index.
+	signersByIndex map[group.MemberIndex]*signer
+	// heartbeatMessages holds the heartbeat messages the wallet was asked to
+	// sign, by their sighash, i.e. the double SHA-256 of the message bytes.
+	heartbeatMessages map[[32]byte][16]byte
+	// creationBlock is the number of the block at which the wallet was
+	// registered on-chain. The wallet is registered on-chain only once the
+	// DKG result is approved, which happens after the wallet's signers are
+	// registered by the node so, the value is resolved from the chain
+	// afterwards and is not persisted. Zero means the creation block is
+	// not known yet.
+	creationBlock uint64
+}
+
+// addSigner adds the given signer to the cached wallet signers.
+func (wcv *walletCacheValue) addSigner(s *signer) {
+	if wcv.signersByIndex == nil {
+		wcv.signersByIndex = make(map[group.MemberIndex]*signer)
+	}
+
+	wcv.signers = append(wcv.signers, s)
+	wcv.signersByIndex[s.signingGroupMemberIndex] = s
 }
 
 // newWalletRegistry creates a new instance of the walletRegistry.
@@ -57,6 +90,7 @@ func newWalletRegistry(
 	// Pre-populate the wallet cache using the wallet storage.
 	walletCache := make(map[string]*walletCacheValue)
 	walletSigners := walletStorage.loadSigners()
+	walletHeartbeatMessages := walletStorage.loadHeartbeatMessages()
 	if len(walletSigners) > 0 {
 		for walletStorageKey, signers := range walletSigners {
 			// We need to extract the wallet from the signers array. The
@@ -77,10 +111,17 @@ func newWalletRegistry(
 				)
 			}
 
+			signersByIndex := make(map[group.MemberIndex]*signer, len(signers))
+			for _, signer := range signers {
+				signersByIndex[signer.signingGroupMemberIndex] = signer
+			}
+
 			walletCache[walletStorageKey] = &walletCacheValue{
 				walletPublicKeyHash: walletPublicKeyHash,
 				walletID:            walletID,
 				signers:             signers,
+				signersByIndex:      signersByIndex,
+				heartbeatMessages:   walletHeartbeatMessages[walletStorageKey],
 			}
 
 			logger.Infof(
@@ -104,8 +145,8 @@ func newWalletRegistry(
 
 // getWalletsPublicKeys returns public keys of all registered wallets.
 func (wr *walletRegistry) getWalletsPublicKeys() []*ecdsa.PublicKey {
-	wr.mutex.Lock()
-	defer wr.mutex.Unlock()
+	wr.mutex.RLock()
+	defer wr.mutex.RUnlock()
 
 	keys := make([]*ecdsa.PublicKey, 0)
 	for _, value := range wr.walletCache {
@@ -117,6 +158,46 @@ func (wr *walletRegistry) getWalletsPublicKeys() []*ecdsa.PublicKey {
 	return keys
 }
 
+// Len returns the total number of signers held by the walletRegistry,
+// across all wallets.
+func (wr *walletRegistry) Len() int {
+	wr.mutex.RLock()
+	defer wr.mutex.RUnlock()
+
+	count := 0
+	for _, value := range wr.walletCache {
+		count += len(value.signers)
+	}
+
+	return count
+}
+
+// WalletCount returns the number of distinct wallets held by the
+// walletRegistry.
+func (wr *walletRegistry) WalletCount() int {
+	wr.mutex.RLock()
+	defer wr.mutex.RUnlock()
+
+	return len(wr.walletCache)
+}
+
+// ListWallets returns all wallets registered in the walletRegistry. The
+// returned wallets are copies so modifying them does not affect the registry.
+func (wr *walletRegistry) ListWallets() []*wallet {
+	wr.mutex.RLock()
+	defer wr.mutex.RUnlock()
+
+	wallets := make([]*wallet, 0, len(wr.walletCache))
+	for _, value := range wr.walletCache {
+		// We can take the wallet from the first signer. All signers for the
+		// given cache value belong to the same wallet.
+		wallet := value.signers[0].wallet
+		wallets = append(wallets, &wallet)
+	}
+
+	return wallets
+}
+
 // registerSigner registers the given signer using in the walletRegistry.
 func (wr *walletRegistry) registerSigner(signer *signer) error {
 	wr.mutex.Lock()
@@ -131,8 +212,8 @@ func (wr *walletRegistry) registerSigner(signer *signer) error {
 
 	// If the wallet cache does not have the given entry yet, initialize
 	// the value and compute the wallet ID and wallet public key hash. This way,
-	// the hashes are computed only once. No need to initialize signers slice as
-	// appending works with nil values.
+	// the hashes are computed only once. Signers are added to the value
+	// separately.
 	if _, ok := wr.walletCache[walletStorageKey]; !ok {
 		walletID, err := wr.calculateWalletIdFunc(signer.wallet.publicKey)
 		if err != nil {
@@ -145,36 +226,212 @@ func (wr *walletRegistry) registerSigner(signer *signer) error {
 		}
 	}
 
-	wr.walletCache[walletStorageKey].signers = append(
-		wr.walletCache[walletStorageKey].signers,
-		signer,
-	)
+	wr.walletCache[walletStorageKey].addSigner(signer)
 
 	return nil
 }
 
+// setWalletCreationBlock sets the creation block of the given wallet held
+// by the walletRegistry. The function is no-op if the walletRegistry does not
+// hold the given wallet.
+func (wr *walletRegistry) setWalletCreationBlock(
+	walletPublicKey *ecdsa.PublicKey,
+	creationBlock uint64,
+) {
+	wr.mutex.Lock()
+	defer wr.mutex.Unlock()
+
+	value, ok := wr.walletCache[getWalletStorageKey(walletPublicKey)]
+	if !ok {
+		return
+	}
+
+	value.creationBlock = creationBlock
+}
+
+// getWalletCreationBlock returns the creation block of the given wallet.
+// Zero is returned if the creation block is not known yet or the
+// walletRegistry does not hold the given wallet.
+func (wr *walletRegistry) getWalletCreationBlock(
+	walletPublicKey *ecdsa.PublicKey,
+) uint64 {
+	wr.mutex.RLock()
+	defer wr.mutex.RUnlock()
+
+	value, ok := wr.walletCache[getWalletStorageKey(walletPublicKey)]
+	if !ok {
+		return 0
+	}
+
+	return value.creationBlock
+}
+
+// setSigningGroupOperators sets the signing group operators of the given
+// wallet for all its signers held by the walletRegistry. Signers already
+// handed out by the walletRegistry are not modified; they are replaced by
+// new signers holding the given operators instead. The function is no-op
+// if the walletRegistry does not hold the given wallet.
+func (wr *walletRegistry) setSigningGroupOperators(
+	walletPublicKey *ecdsa.PublicKey,
+	signingGroupOperators chain.Addresses,
+) {
+	wr.mutex.Lock()
+	defer wr.mutex.Unlock()
+
+	value, ok := wr.walletCache[getWalletStorageKey(walletPublicKey)]
+	if !ok {
+		return
+	}
+
+	signers := value.signers
+	value.signers = nil
+	value.signersByIndex = nil
+
+	for _, s := range signers {
+		restoredSigner := *s
+		restoredSigner.wallet.signingGroupOperators = signingGroupOperators
+		value.addSigner(&restoredSigner)
+	}
+}
+
+// saveHeartbeatMessage persists the given heartbeat message the given wallet
+// was asked to sign. The message is kept for the wallet's lifetime so the
+// signature produced over it can be defended against a fraud challenge
+// submitted at any time.
+func (wr *walletRegistry) saveHeartbeatMessage(
+	walletPublicKey *ecdsa.PublicKey,
+	message [16]byte,
+) error {
+	wr.mutex.Lock()
+	defer wr.mutex.Unlock()
+
+	value, ok := wr.walletCache[getWalletStorageKey(walletPublicKey)]
+	if !ok {
+		return fmt.Errorf("wallet not found in the wallet cache")
+	}
+
+	sighash := bitcoin.ComputeHash(message[:])
+
+	if _, ok := value.heartbeatMessages[sighash]; ok {
+		return nil
+	}
+
+	err := wr.walletStorage.saveHeartbeatMessage(walletPublicKey, message)
+	if err != nil {
+		return fmt.Errorf("could not save heartbeat message: [%v]", err)
+	}
+
+	if value.heartbeatMessages == nil {
+		value.heartbeatMessages = make(map[[32]byte][16]byte)
+	}
+	value.heartbeatMessages[sighash] = message
+
+	return nil
+}
+
+// getHeartbeatMessage gets the heartbeat message with the given sighash the
+// given wallet was asked to sign. The second return value is false if the
+// message is not known.
+func (wr *walletRegistry) getHeartbeatMessage(
+	walletPublicKey *ecdsa.PublicKey,
+	sighash [32]byte,
+) ([16]byte, bool) {
+	wr.mutex.RLock()
+	defer wr.mutex.RUnlock()
+
+	value, ok := wr.walletCache[getWalletStorageKey(walletPublicKey)]
+	if !ok {
+		return [16]byte{}, false
+	}
+
+	message, ok := value.heartbeatMessages[sighash]
+	return message, ok
+}
+
 // getSigners gets all signers for the given wallet held by the walletRegistry.
 func (wr *walletRegistry) getSigners(
 	walletPublicKey *ecdsa.PublicKey,
 ) []*signer {
-	wr.mutex.Lock()
-	defer wr.mutex.Unlock()
+	wr.mutex.RLock()
+	defer wr.mutex.RUnlock()
 
 	if value, ok := wr.walletCache[getWalletStorageKey(walletPublicKey)]; ok {
-		return value.signers
+		// Return a copy so the caller's slice is not affected by signers
+		// registered concurrently.
+		signers := make([]*signer, len(value.signers))
+		copy(signers, value.signers)
+		return signers
 	}
 
 	return nil
 }
 
+// FindSignerByIndex gets the signer of the given wallet that holds the given
+// signing group member index. The second boolean return value indicates
+// whether such a signer is held by the walletRegistry.
+func (wr *walletRegistry) FindSignerByIndex(
+	walletPublicKey *ecdsa.PublicKey,
+	memberIndex group.MemberIndex,
+) (*signer, bool) {
+	wr.mutex.RLock()
+	defer wr.mutex.RUnlock()
+
+	value, ok := wr.walletCache[getWalletStorageKey(walletPublicKey)]
+	if !ok {
+		return nil, false
+	}
+
+	signer, ok := value.signersByIndex[memberIndex]
+	return signer, ok
+}
+
+// findCorruptedSigners loads signers stored using the underlying persistence
+// layer and compares their private key shares with the in-memory copies.
+// It returns in-memory signers whose persisted copies are missing or hold
+// a different private key share.
+func (wr *walletRegistry) findCorruptedSigners() []*signer {
+	// Holding the read lock while loading signers guarantees the persisted
+	// signers are not changed by concurrent signer registrations and wallet archiving.
+	wr.mutex.RLock()
+	defer wr.mutex.RUnlock()
+
+	persistedSigners := wr.walletStorage.loadSigners()
+
+	corruptedSigners := make([]*signer, 0)
+	for walletStorageKey, value := range wr.walletCache {
+		for _, signer := range value.signers {
+			isHealthy := false
+
+			for _, persistedSigner := range persistedSigners[walletStorageKey] {
+				if persistedSigner.signingGroupMemberIndex !=
+					signer.signingGroupMemberIndex {
+					continue
+				}
+
+				isHealthy = reflect.DeepEqual(
+					persistedSigner.privateKeyShare,
+					signer.privateKeyShare,
+				)
+				break
+			}
+
+			if !isHealthy {
+				corruptedSigners = append(corruptedSigners, signer)
+			}
+		}
+	}
+
+	return corruptedSigners
+}
+
 // getWalletByPublicKeyHash gets the given wallet by its 20-byte wallet
 // public key hash. Second boolean return value denotes whether the wallet
 // was found in the registry or not.
 func (wr *walletRegistry) getWalletByPublicKeyHash(
 	walletPublicKeyHash [20]byte,
 ) (wallet, bool) {
-	wr.mutex.Lock()
-	defer wr.mutex.Unlock()
+	wr.mutex.RLock()
+	defer wr.mutex.RUnlock()
 
 	for _, value := range wr.walletCache {
 		if value.walletPublicKeyHash == walletPublicKeyHash {
@@ -190,8 +447,8 @@ func (wr *walletRegistry) getWalletByPublicKeyHash(
 // getWalletByID gets the given wallet by its 32-byte wallet ID. Second boolean
 // return value denotes whether the wallet was found in the registry or not.
 func (wr *walletRegistry) getWalletByID(walletID [32]byte) (wallet, bool) {
-	wr.mutex.Lock()
-	defer wr.mutex.Unlock()
+	wr.mutex.RLock()
+	defer wr.mutex.RUnlock()
 
 	for _, value := range wr.walletCache {
 		if value.walletID == walletID {
@@ -280,6 +537,32 @@ func (ws *walletStorage) saveSigner(signer *signer) error {
 	return nil
 }
 
+// saveHeartbeatMessage saves the given heartbeat message signed by the given
+// wallet using the underlying persistence layer of the walletStorage. It does
+// not add the message to any in-memory cache and should not be called from
+// any other place than walletRegistry.
+func (ws *walletStorage) saveHeartbeatMessage(
+	walletPublicKey *ecdsa.PublicKey,
+	message [16]byte,
+) error {
+	sighash := bitcoin.ComputeHash(message[:])
+
+	err := ws.persistence.Save(
+		message[:],
+		getWalletStorageKey(walletPublicKey),
+		fmt.Sprintf("/%v%x", heartbeatMessageFilePrefix, sighash),
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"could not save heartbeat message using the "+
+				"underlying persistence layer: [%w]",
+			err,
+		)
+	}
+
+	return nil
+}
+
 // archiveWallet archives the given wallet data in the underlying persistence
 // layer of the walletStorage.
 func (ws *walletStorage) archiveWallet(walletStorageKey string) error {
@@ -313,6 +596,10 @@ func (ws *walletStorage) loadSigners() map[string][]*signer {
 
 	go func() {
 		for descriptor := range descriptorsChan {
+			if isHeartbeatMessageFile(descriptor) {
+				continue
+			}
+
 			content, err := descriptor.Content()
 			if err != nil {
 				logger.Errorf(
@@ -364,6 +651,87 @@ func (ws *walletStorage) loadSigners() map[string][]*signer {
 	return signersByWallet
 }
 
+// loadHeartbeatMessages loads all heartbeat messages stored using the
+// underlying persistence layer, by wallet storage key and message sighash.
+// This function should not be called from any other place than
+// walletRegistry.
+func (ws *walletStorage) loadHeartbeatMessages() map[string]map[[32]byte][16]byte {
+	messagesByWallet := make(map[string]map[[32]byte][16]byte)
+
+	descriptorsChan, errorsChan := ws.persistence.ReadAll()
+
+	// See loadSigners for the rationale of using two goroutines.
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		for descriptor := range descriptorsChan {
+			if !isHeartbeatMessageFile(descriptor) {
+				continue
+			}
+
+			content, err := descriptor.Content()
+			if err != nil {
+				logger.Errorf(
+					"could not get content from file [%v] "+
+						"in directory [%v]: [%v]",
+					descriptor.Name(),
+					descriptor.Directory(),
+					err,
+				)
+				continue
+			}
+
+			var message [16]byte
+			if len(content) != len(message) {
+				logger.Errorf(
+					"unexpected heartbeat message length [%v] in file [%v] "+
+						"in directory [%v]",
+					len(content),
+					descriptor.Name(),
+					descriptor.Directory(),
+				)
+				continue
+			}
+			copy(message[:], content)
+
+			walletStorageKey := descriptor.Directory()
+			if _, ok := messagesByWallet[walletStorageKey]; !ok {
+				messagesByWallet[walletStorageKey] = make(map[[32]byte][16]byte)
+			}
+
+			sighash := bitcoin.ComputeHash(message[:])
+			messagesByWallet[walletStorageKey][sighash] = message
+		}
+
+		wg.Done()
+	}()
+
+	go func() {
+		for err := range errorsChan {
+			logger.Errorf(
+				"could not load heartbeat message from disk: [%v]",
+				err,
+			)
+		}
+
+		wg.Done()
+	}()
+
+	wg.Wait()
+
+	return messagesByWallet
+}
+
+// isHeartbeatMessageFile returns true if the given descriptor points to
+// a file holding a heartbeat message.
+func isHeartbeatMessageFile(descriptor persistence.DataDescriptor) bool {
+	return strings.HasPrefix(
+		strings.TrimPrefix(descriptor.Name(), "/"),
+		heartbeatMessageFilePrefix,
+	)
+}
+
 // getWalletStorageKey compute the wallet storage key that is used to identify
 // the given wallet for caching and storage purposes.
 func getWalletStorageKey(walletPublicKey *ecdsa.PublicKey) string {
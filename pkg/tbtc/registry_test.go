@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/keep-network/keep-core/pkg/bitcoin"
+	"github.com/keep-network/keep-core/pkg/internal/tecdsatest"
+	"github.com/keep-network/keep-core/pkg/protocol/group"
 	"github.com/keep-network/keep-core/pkg/tecdsa"
 
 	"github.com/keep-network/keep-common/pkg/persistence"
@@ -101,6 +104,205 @@ func TestWalletRegistry_GetSigners(t *testing.T) {
 	}
 }
 
+func TestWalletRegistry_FindSignerByIndex(t *testing.T) {
+	persistenceHandle := &mockPersistenceHandle{}
+	chain := Connect()
+
+	walletRegistry, err := newWalletRegistry(
+		persistenceHandle,
+		chain.CalculateWalletID,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testData, err := tecdsatest.LoadPrivateKeyShareTestFixtures(3)
+	if err != nil {
+		t.Fatalf("failed to load test data: [%v]", err)
+	}
+
+	// Register signers for members 1 and 3 of the same wallet. The signer
+	// for member 2 is not controlled by the node.
+	signers := make(map[group.MemberIndex]*signer)
+	for _, memberIndex := range []group.MemberIndex{1, 3} {
+		privateKeyShare := tecdsa.NewPrivateKeyShare(testData[memberIndex-1])
+
+		signer := &signer{
+			wallet: wallet{
+				publicKey: privateKeyShare.PublicKey(),
+			},
+			signingGroupMemberIndex: memberIndex,
+			privateKeyShare:         privateKeyShare,
+		}
+
+		err = walletRegistry.registerSigner(signer)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		signers[memberIndex] = signer
+	}
+
+	walletPublicKey := signers[1].wallet.publicKey
+
+	var tests = map[string]struct {
+		walletPublicKey *ecdsa.PublicKey
+		memberIndex     group.MemberIndex
+		expectedSigner  *signer
+		expectedFound   bool
+	}{
+		"first signer of the wallet": {
+			walletPublicKey: walletPublicKey,
+			memberIndex:     1,
+			expectedSigner:  signers[1],
+			expectedFound:   true,
+		},
+		"second signer of the wallet": {
+			walletPublicKey: walletPublicKey,
+			memberIndex:     3,
+			expectedSigner:  signers[3],
+			expectedFound:   true,
+		},
+		"member not controlled by the node": {
+			walletPublicKey: walletPublicKey,
+			memberIndex:     2,
+			expectedFound:   false,
+		},
+		"unknown wallet": {
+			// The curve's base point is a valid public key that is not
+			// used by any registered wallet.
+			walletPublicKey: &ecdsa.PublicKey{
+				Curve: tecdsa.Curve,
+				X:     tecdsa.Curve.Params().Gx,
+				Y:     tecdsa.Curve.Params().Gy,
+			},
+			memberIndex:   1,
+			expectedFound: false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			signer, found := walletRegistry.FindSignerByIndex(
+				test.walletPublicKey,
+				test.memberIndex,
+			)
+
+			testutils.AssertBoolsEqual(
+				t,
+				"signer found",
+				test.expectedFound,
+				found,
+			)
+
+			if signer != test.expectedSigner {
+				t.Errorf(
+					"unexpected signer\nexpected: %v\nactual:   %v",
+					test.expectedSigner,
+					signer,
+				)
+			}
+		})
+	}
+}
+
+func TestWalletRegistry_FindCorruptedSigners(t *testing.T) {
+	testData, err := tecdsatest.LoadPrivateKeyShareTestFixtures(2)
+	if err != nil {
+		t.Fatalf("failed to load test data: [%v]", err)
+	}
+
+	var tests = map[string]struct {
+		corruptPersistenceFn     func(handle *mockPersistenceHandle, signer *signer)
+		expectedCorruptedSigners int
+	}{
+		"persisted signer matches the in-memory copy": {
+			corruptPersistenceFn: func(
+				handle *mockPersistenceHandle,
+				signer *signer,
+			) {
+			},
+			expectedCorruptedSigners: 0,
+		},
+		"persisted signer is truncated": {
+			corruptPersistenceFn: func(
+				handle *mockPersistenceHandle,
+				signer *signer,
+			) {
+				descriptor := handle.saved[0].(*mockDescriptor)
+				descriptor.content = descriptor.content[:len(descriptor.content)/2]
+			},
+			expectedCorruptedSigners: 1,
+		},
+		"persisted signer holds a different key share": {
+			corruptPersistenceFn: func(
+				handle *mockPersistenceHandle,
+				signer *signer,
+			) {
+				corruptedSigner := *signer
+				corruptedSigner.privateKeyShare = tecdsa.NewPrivateKeyShare(
+					testData[1],
+				)
+
+				content, err := corruptedSigner.Marshal()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				handle.saved[0].(*mockDescriptor).content = content
+			},
+			expectedCorruptedSigners: 1,
+		},
+		"persisted signer is missing": {
+			corruptPersistenceFn: func(
+				handle *mockPersistenceHandle,
+				signer *signer,
+			) {
+				handle.saved = nil
+			},
+			expectedCorruptedSigners: 1,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			persistenceHandle := &mockPersistenceHandle{}
+			chain := Connect()
+
+			walletRegistry, err := newWalletRegistry(
+				persistenceHandle,
+				chain.CalculateWalletID,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			signer := createMockSigner(t)
+
+			err = walletRegistry.registerSigner(signer)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			test.corruptPersistenceFn(persistenceHandle, signer)
+
+			corruptedSigners := walletRegistry.findCorruptedSigners()
+
+			testutils.AssertIntsEqual(
+				t,
+				"corrupted signers count",
+				test.expectedCorruptedSigners,
+				len(corruptedSigners),
+			)
+
+			if len(corruptedSigners) > 0 &&
+				!reflect.DeepEqual(signer, corruptedSigners[0]) {
+				t.Errorf("unexpected corrupted signer")
+			}
+		})
+	}
+}
+
 func TestWalletRegistry_getWalletByPublicKeyHash(t *testing.T) {
 	persistenceHandle := &mockPersistenceHandle{}
 	chain := Connect()
@@ -318,6 +520,267 @@ func TestWalletRegistry_GetWalletsPublicKeys(t *testing.T) {
 	)
 }
 
+func TestWalletRegistry_ListWallets(t *testing.T) {
+	persistenceHandle := &mockPersistenceHandle{}
+	chain := Connect()
+
+	walletRegistry, err := newWalletRegistry(
+		persistenceHandle,
+		chain.CalculateWalletID,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutils.AssertIntsEqual(
+		t,
+		"wallets count before registration",
+		0,
+		len(walletRegistry.ListWallets()),
+	)
+
+	signer := createMockSigner(t)
+
+	err = walletRegistry.registerSigner(signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wallets := walletRegistry.ListWallets()
+
+	testutils.AssertIntsEqual(t, "wallets count", 1, len(wallets))
+	testutils.AssertStringsEqual(
+		t,
+		"wallet",
+		signer.wallet.String(),
+		wallets[0].String(),
+	)
+}
+
+func TestWalletRegistry_LenAndWalletCount(t *testing.T) {
+	persistenceHandle := &mockPersistenceHandle{}
+	chain := Connect()
+
+	walletRegistry, err := newWalletRegistry(
+		persistenceHandle,
+		chain.CalculateWalletID,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertCounts := func(
+		description string,
+		expectedLen int,
+		expectedWalletCount int,
+	) {
+		testutils.AssertIntsEqual(
+			t,
+			fmt.Sprintf("signers count %s", description),
+			expectedLen,
+			walletRegistry.Len(),
+		)
+		testutils.AssertIntsEqual(
+			t,
+			fmt.Sprintf("wallets count %s", description),
+			expectedWalletCount,
+			walletRegistry.WalletCount(),
+		)
+	}
+
+	assertCounts("before registration", 0, 0)
+
+	// Two signers of the first wallet.
+	firstWalletSigners := make([]*signer, 2)
+	for i := range firstWalletSigners {
+		firstWalletSigners[i] = createMockSigner(t)
+		firstWalletSigners[i].signingGroupMemberIndex = group.MemberIndex(i + 1)
+	}
+
+	// One signer of the second wallet. Only the wallet public key matters
+	// for the registry so, the private key share is left as is.
+	secondWalletSigner := createMockSigner(t)
+	secondWalletSigner.wallet.publicKey = &ecdsa.PublicKey{
+		Curve: tecdsa.Curve,
+		X:     tecdsa.Curve.Params().Gx,
+		Y:     tecdsa.Curve.Params().Gy,
+	}
+
+	err = walletRegistry.registerSigner(firstWalletSigners[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertCounts("after first registration", 1, 1)
+
+	err = walletRegistry.registerSigner(secondWalletSigner)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletRegistry.registerSigner(firstWalletSigners[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertCounts("after all registrations", 3, 2)
+
+	err = walletRegistry.archiveWallet(
+		bitcoin.PublicKeyHash(firstWalletSigners[0].wallet.publicKey),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertCounts("after first wallet archiving", 1, 1)
+
+	// Registering a signer of the archived wallet again brings the wallet
+	// back to the registry.
+	err = walletRegistry.registerSigner(firstWalletSigners[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertCounts("after re-registration", 2, 2)
+
+	err = walletRegistry.archiveWallet(
+		bitcoin.PublicKeyHash(secondWalletSigner.wallet.publicKey),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertCounts("after second wallet archiving", 1, 1)
+}
+
+func TestWalletRegistry_ListWallets_ConcurrentRegistration(t *testing.T) {
+	persistenceHandle := &mockPersistenceHandle{}
+	chain := Connect()
+
+	walletRegistry, err := newWalletRegistry(
+		persistenceHandle,
+		chain.CalculateWalletID,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signersCount := 10
+
+	signers := make([]*signer, signersCount)
+	for i := range signers {
+		signers[i] = createMockSigner(t)
+		signers[i].signingGroupMemberIndex = group.MemberIndex(i + 1)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2 * signersCount)
+
+	for _, walletSigner := range signers {
+		go func(walletSigner *signer) {
+			defer wg.Done()
+
+			if err := walletRegistry.registerSigner(walletSigner); err != nil {
+				t.Error(err)
+			}
+		}(walletSigner)
+
+		go func() {
+			defer wg.Done()
+
+			// All signers belong to the same wallet so, the registry must
+			// never report more than one wallet.
+			if wallets := walletRegistry.ListWallets(); len(wallets) > 1 {
+				t.Errorf("unexpected wallets count: [%v]", len(wallets))
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	wallets := walletRegistry.ListWallets()
+
+	testutils.AssertIntsEqual(t, "wallets count", 1, len(wallets))
+	testutils.AssertIntsEqual(
+		t,
+		"wallet signers count",
+		signersCount,
+		len(walletRegistry.getSigners(wallets[0].publicKey)),
+	)
+}
+
+// TestWalletRegistry_ConcurrentAccess exercises all registry operations at
+// once and is meant to be run with the race detector enabled.
+func TestWalletRegistry_ConcurrentAccess(t *testing.T) {
+	persistenceHandle := &mockPersistenceHandle{}
+	chain := Connect()
+
+	walletRegistry, err := newWalletRegistry(
+		persistenceHandle,
+		chain.CalculateWalletID,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	goroutinesCount := 10
+
+	signers := make([]*signer, goroutinesCount)
+	for i := range signers {
+		signers[i] = createMockSigner(t)
+		signers[i].signingGroupMemberIndex = group.MemberIndex(i + 1)
+	}
+
+	walletPublicKey := signers[0].wallet.publicKey
+	walletPublicKeyHash := bitcoin.PublicKeyHash(walletPublicKey)
+
+	expectedArchiveErr := fmt.Errorf("wallet not found in the wallet cache")
+
+	var wg sync.WaitGroup
+	wg.Add(goroutinesCount)
+
+	for _, walletSigner := range signers {
+		go func(walletSigner *signer) {
+			defer wg.Done()
+
+			if err := walletRegistry.registerSigner(walletSigner); err != nil {
+				t.Error(err)
+			}
+
+			for _, registeredSigner := range walletRegistry.getSigners(
+				walletPublicKey,
+			) {
+				if !registeredSigner.wallet.publicKey.Equal(walletPublicKey) {
+					t.Errorf("signer of an unexpected wallet returned")
+				}
+			}
+
+			// All signers belong to the same wallet so, the registry must
+			// never report more than one wallet.
+			if wallets := walletRegistry.ListWallets(); len(wallets) > 1 {
+				t.Errorf("unexpected wallets count: [%v]", len(wallets))
+			}
+
+			// The wallet may have been already archived by another goroutine.
+			err := walletRegistry.archiveWallet(walletPublicKeyHash)
+			if err != nil && !reflect.DeepEqual(err, expectedArchiveErr) {
+				t.Errorf("unexpected archive error: [%v]", err)
+			}
+		}(walletSigner)
+	}
+
+	wg.Wait()
+
+	// Each goroutine archives the wallet after registering its signer so,
+	// the last archive call always leaves the registry empty.
+	testutils.AssertIntsEqual(
+		t,
+		"wallets count",
+		0,
+		len(walletRegistry.ListWallets()),
+	)
+}
+
 func TestWalletRegistry_ArchiveWallet(t *testing.T) {
 	persistenceHandle := &mockPersistenceHandle{}
 	chain := Connect()
@@ -464,6 +927,57 @@ func TestWalletStorage_LoadSigners(t *testing.T) {
 	}
 }
 
+func TestWalletStorage_HeartbeatMessages(t *testing.T) {
+	persistenceHandle := &mockPersistenceHandle{}
+
+	walletStorage := newWalletStorage(persistenceHandle)
+
+	signer := createMockSigner(t)
+
+	err := walletStorage.saveSigner(signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	message := [16]byte{0xff, 0x01, 0x02}
+
+	err = walletStorage.saveHeartbeatMessage(signer.wallet.publicKey, message)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	walletStorageKey := getWalletStorageKey(signer.wallet.publicKey)
+
+	// Heartbeat messages are stored next to the signers but must not be
+	// loaded as signers.
+	signersByWallet := walletStorage.loadSigners()
+	testutils.AssertIntsEqual(
+		t,
+		"loaded wallet signers count",
+		1,
+		len(signersByWallet[walletStorageKey]),
+	)
+
+	messagesByWallet := walletStorage.loadHeartbeatMessages()
+	testutils.AssertIntsEqual(
+		t,
+		"loaded wallets count",
+		1,
+		len(messagesByWallet),
+	)
+
+	expectedMessages := map[[32]byte][16]byte{
+		bitcoin.ComputeHash(message[:]): message,
+	}
+	if !reflect.DeepEqual(expectedMessages, messagesByWallet[walletStorageKey]) {
+		t.Errorf(
+			"unexpected heartbeat messages\nexpected: %v\nactual:   %v",
+			expectedMessages,
+			messagesByWallet[walletStorageKey],
+		)
+	}
+}
+
 func TestWalletStorage_ArchiveWallet(t *testing.T) {
 	persistenceHandle := &mockPersistenceHandle{}
 
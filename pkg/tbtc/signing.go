@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/keep-network/keep-core/pkg/generator"
 	"github.com/keep-network/keep-core/pkg/net"
@@ -15,6 +16,7 @@ import (
 	"github.com/keep-network/keep-core/pkg/tecdsa/signing"
 	"go.uber.org/zap"
 	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
 )
 
 const (
@@ -38,6 +40,11 @@ const (
 // cannot execute the requested signature due to an ongoing signing.
 var errSigningExecutorBusy = fmt.Errorf("signing executor is busy")
 
+// ErrSigningRateLimited is an error returned when the signing executor
+// rejects the requested signature because signing requests arrive faster
+// than the configured signing rate limit.
+var ErrSigningRateLimited = fmt.Errorf("signing rate limit exceeded")
+
 // signingExecutor is a component responsible for executing signing related to
 // a specific wallet whose part is controlled by this node.
 type signingExecutor struct {
@@ -58,6 +65,13 @@ type signingExecutor struct {
 	// be made by a single signer for the given message. Once the attempts
 	// limit is hit the signer gives up.
 	signingAttemptsLimit uint
+
+	// rateLimiter limits the rate of incoming signing requests. A signing
+	// batch counts as a single request.
+	rateLimiter *rate.Limiter
+	// rateLimitedRequests is the total number of signing requests rejected
+	// by the rate limiter.
+	rateLimitedRequests atomic.Uint64
 }
 
 func newSigningExecutor(
@@ -69,6 +83,7 @@ func newSigningExecutor(
 	getCurrentBlockFn getCurrentBlockFn,
 	waitForBlockFn waitForBlockFn,
 	signingAttemptsLimit uint,
+	signingRateLimit rate.Limit,
 ) *signingExecutor {
 	return &signingExecutor{
 		lock:                 semaphore.NewWeighted(1),
@@ -80,7 +95,25 @@ func newSigningExecutor(
 		getCurrentBlockFn:    getCurrentBlockFn,
 		waitForBlockFn:       waitForBlockFn,
 		signingAttemptsLimit: signingAttemptsLimit,
+		rateLimiter:          rate.NewLimiter(signingRateLimit, 1),
+	}
+}
+
+// checkRateLimit returns ErrSigningRateLimited if the signing request rate
+// limit is exceeded. Otherwise, it returns nil and consumes the limit.
+func (se *signingExecutor) checkRateLimit() error {
+	if !se.rateLimiter.Allow() {
+		se.rateLimitedRequests.Add(1)
+		return ErrSigningRateLimited
 	}
+
+	return nil
+}
+
+// rateLimitedRequestsTotal returns the total number of signing requests
+// rejected by the rate limiter.
+func (se *signingExecutor) rateLimitedRequestsTotal() uint64 {
+	return se.rateLimitedRequests.Load()
 }
 
 // signBatch performs the signing process for each message from the given
@@ -94,6 +127,17 @@ func (se *signingExecutor) signBatch(
 	messages []*big.Int,
 	startBlock uint64,
 ) ([]*tecdsa.Signature, error) {
+	// The lock is held for the whole batch so a busy executor does not
+	// consume the rate limit and no other signing interleaves the batch.
+	if lockAcquired := se.lock.TryAcquire(1); !lockAcquired {
+		return nil, errSigningExecutorBusy
+	}
+	defer se.lock.Release(1)
+
+	if err := se.checkRateLimit(); err != nil {
+		return nil, err
+	}
+
 	wallet := se.wallet()
 
 	walletPublicKeyBytes, err := marshalPublicKey(wallet.publicKey)
@@ -145,7 +189,11 @@ func (se *signingExecutor) signBatch(
 			signingStartBlock = endBlocks[i-1] + signingBatchInterludeBlocks
 		}
 
-		signature, _, endBlock, err := se.sign(ctx, message, signingStartBlock)
+		signature, _, endBlock, err := se.signLocked(
+			ctx,
+			message,
+			signingStartBlock,
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -169,7 +217,8 @@ func (se *signingExecutor) signBatch(
 // signed successfully, this function returns the signature along with the
 // number of active members that participated in signing, the block at which the
 // signature was calculated. The end block is common for all wallet signers so
-// can be used as a synchronization point.
+// can be used as a synchronization point. If signing requests arrive faster
+// than the signing rate limit allows, ErrSigningRateLimited is returned.
 func (se *signingExecutor) sign(
 	ctx context.Context,
 	message *big.Int,
@@ -180,6 +229,20 @@ func (se *signingExecutor) sign(
 	}
 	defer se.lock.Release(1)
 
+	if err := se.checkRateLimit(); err != nil {
+		return nil, nil, 0, err
+	}
+
+	return se.signLocked(ctx, message, startBlock)
+}
+
+// signLocked performs the signing process for the given message. The caller
+// must hold the signing executor lock.
+func (se *signingExecutor) signLocked(
+	ctx context.Context,
+	message *big.Int,
+	startBlock uint64,
+) (*tecdsa.Signature, *signingActivityReport, uint64, error) {
 	wallet := se.wallet()
 
 	walletPublicKeyBytes, err := marshalPublicKey(wallet.publicKey)
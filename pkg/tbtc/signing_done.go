@@ -25,6 +25,14 @@ const signingDoneReceiveBuffer = 512
 // to consider the signing as done are met, in waitUntilAllDone.
 const signingDoneCheckInterval = 100 * time.Millisecond
 
+// signingDoneSignalAttempts determines the maximum number of attempts to
+// broadcast the signing done message, in signalDone.
+const signingDoneSignalAttempts = 3
+
+// signingDoneSignalRetryDelay is the delay between subsequent attempts to
+// broadcast the signing done message, in signalDone.
+const signingDoneSignalRetryDelay = 5 * time.Second
+
 // errWaitDoneTimedOut is returned by waitUntilAllDone if it did not receive
 // valid done checks from all members on time.
 var errWaitDoneTimedOut = fmt.Errorf("cannot receive signing done messages on time")
@@ -49,6 +57,7 @@ type signingDoneCheck struct {
 	groupSize           int
 	broadcastChannel    net.BroadcastChannel
 	membershipValidator *group.MembershipValidator
+	signalRetryDelay    time.Duration
 
 	receiveCtx           context.Context
 	cancelReceiveCtx     context.CancelFunc
@@ -66,6 +75,7 @@ func newSigningDoneCheck(
 		groupSize:           groupSize,
 		broadcastChannel:    broadcastChannel,
 		membershipValidator: membershipValidator,
+		signalRetryDelay:    signingDoneSignalRetryDelay,
 	}
 }
 
@@ -129,7 +139,10 @@ func (sdc *signingDoneCheck) listen(
 }
 
 // signalDone broadcasts the signing done check along with information necessary
-// to attribute the result to the given signing attempt.
+// to attribute the result to the given signing attempt. A failed broadcast is
+// retried up to signingDoneSignalAttempts times as otherwise other members
+// would wait for the done check until their context is done. Retries stop
+// once the passed context is done.
 func (sdc *signingDoneCheck) signalDone(
 	ctx context.Context,
 	memberIndex group.MemberIndex,
@@ -138,13 +151,64 @@ func (sdc *signingDoneCheck) signalDone(
 	result *signing.Result,
 	endBlock uint64,
 ) error {
-	return sdc.broadcastChannel.Send(ctx, &signingDoneMessage{
+	doneMessage := &signingDoneMessage{
 		senderID:      memberIndex,
 		message:       message,
 		attemptNumber: attemptNumber,
 		signature:     result.Signature,
 		endBlock:      endBlock,
-	}, net.BackoffRetransmissionStrategy)
+	}
+
+	var err error
+	for attempt := 1; attempt <= signingDoneSignalAttempts; attempt++ {
+		err = sdc.broadcastChannel.Send(
+			ctx,
+			doneMessage,
+			net.BackoffRetransmissionStrategy,
+		)
+		if err == nil {
+			return nil
+		}
+
+		logger.Warnf(
+			"[member:%v] failed to send signing done message in "+
+				"attempt [%v/%v]: [%v]",
+			memberIndex,
+			attempt,
+			signingDoneSignalAttempts,
+			err,
+		)
+
+		if attempt == signingDoneSignalAttempts {
+			break
+		}
+
+		select {
+		case <-time.After(sdc.signalRetryDelay):
+		case <-ctx.Done():
+			logger.Errorf(
+				"[member:%v] giving up sending signing done message: [%v]",
+				memberIndex,
+				ctx.Err(),
+			)
+			return fmt.Errorf(
+				"failed to send signing done message: [%v]",
+				err,
+			)
+		}
+	}
+
+	logger.Errorf(
+		"[member:%v] failed to send signing done message after [%v] attempts",
+		memberIndex,
+		signingDoneSignalAttempts,
+	)
+
+	return fmt.Errorf(
+		"failed to send signing done message after [%v] attempts: [%v]",
+		signingDoneSignalAttempts,
+		err,
+	)
 }
 
 // waitUntilAllDone blocks until it receives all the required done checks from
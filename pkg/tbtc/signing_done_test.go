@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -293,6 +294,168 @@ func TestSigningDoneCheck_AnotherSignature(t *testing.T) {
 	}
 }
 
+func TestSigningDoneCheck_SignalDone_TransientFailure(t *testing.T) {
+	groupParameters := &GroupParameters{
+		GroupSize:       5,
+		GroupQuorum:     4,
+		HonestThreshold: 3,
+	}
+
+	doneCheck := setupSigningDoneCheck(t, groupParameters)
+	broadcastChannel := &failingBroadcastChannel{
+		BroadcastChannel: doneCheck.broadcastChannel,
+		failures:         signingDoneSignalAttempts - 1,
+	}
+	doneCheck.broadcastChannel = broadcastChannel
+	doneCheck.signalRetryDelay = 10 * time.Millisecond
+
+	err := doneCheck.signalDone(
+		context.Background(),
+		1,
+		big.NewInt(100),
+		1,
+		&signing.Result{Signature: &tecdsa.Signature{
+			R:          big.NewInt(200),
+			S:          big.NewInt(300),
+			RecoveryID: 2,
+		}},
+		100,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutils.AssertIntsEqual(
+		t,
+		"send attempts",
+		signingDoneSignalAttempts,
+		broadcastChannel.attempts,
+	)
+}
+
+func TestSigningDoneCheck_SignalDone_AllAttemptsFailed(t *testing.T) {
+	groupParameters := &GroupParameters{
+		GroupSize:       5,
+		GroupQuorum:     4,
+		HonestThreshold: 3,
+	}
+
+	doneCheck := setupSigningDoneCheck(t, groupParameters)
+	broadcastChannel := &failingBroadcastChannel{
+		BroadcastChannel: doneCheck.broadcastChannel,
+		failures:         signingDoneSignalAttempts,
+	}
+	doneCheck.broadcastChannel = broadcastChannel
+	doneCheck.signalRetryDelay = 10 * time.Millisecond
+
+	err := doneCheck.signalDone(
+		context.Background(),
+		1,
+		big.NewInt(100),
+		1,
+		&signing.Result{Signature: &tecdsa.Signature{
+			R:          big.NewInt(200),
+			S:          big.NewInt(300),
+			RecoveryID: 2,
+		}},
+		100,
+	)
+
+	expectedErr := fmt.Errorf(
+		"failed to send signing done message after [3] attempts: [%v]",
+		errBroadcastFailed,
+	)
+	if !reflect.DeepEqual(expectedErr, err) {
+		t.Errorf(
+			"unexpected error\nexpected: [%v]\nactual:   [%v]",
+			expectedErr,
+			err,
+		)
+	}
+
+	testutils.AssertIntsEqual(
+		t,
+		"send attempts",
+		signingDoneSignalAttempts,
+		broadcastChannel.attempts,
+	)
+}
+
+func TestSigningDoneCheck_SignalDone_ContextDone(t *testing.T) {
+	groupParameters := &GroupParameters{
+		GroupSize:       5,
+		GroupQuorum:     4,
+		HonestThreshold: 3,
+	}
+
+	doneCheck := setupSigningDoneCheck(t, groupParameters)
+	broadcastChannel := &failingBroadcastChannel{
+		BroadcastChannel: doneCheck.broadcastChannel,
+		failures:         signingDoneSignalAttempts,
+	}
+	doneCheck.broadcastChannel = broadcastChannel
+	// Make sure the retry delay exceeds the context timeout by far.
+	doneCheck.signalRetryDelay = time.Minute
+
+	ctx, cancelCtx := context.WithTimeout(
+		context.Background(),
+		100*time.Millisecond,
+	)
+	defer cancelCtx()
+
+	err := doneCheck.signalDone(
+		ctx,
+		1,
+		big.NewInt(100),
+		1,
+		&signing.Result{Signature: &tecdsa.Signature{
+			R:          big.NewInt(200),
+			S:          big.NewInt(300),
+			RecoveryID: 2,
+		}},
+		100,
+	)
+
+	expectedErr := fmt.Errorf(
+		"failed to send signing done message: [%v]",
+		errBroadcastFailed,
+	)
+	if !reflect.DeepEqual(expectedErr, err) {
+		t.Errorf(
+			"unexpected error\nexpected: [%v]\nactual:   [%v]",
+			expectedErr,
+			err,
+		)
+	}
+
+	testutils.AssertIntsEqual(t, "send attempts", 1, broadcastChannel.attempts)
+}
+
+var errBroadcastFailed = fmt.Errorf("broadcast failed")
+
+// failingBroadcastChannel is a broadcast channel whose Send fails the given
+// number of times before delegating to the wrapped channel.
+type failingBroadcastChannel struct {
+	net.BroadcastChannel
+
+	failures int
+	attempts int
+}
+
+func (fbc *failingBroadcastChannel) Send(
+	ctx context.Context,
+	message net.TaggedMarshaler,
+	strategy ...net.RetransmissionStrategy,
+) error {
+	fbc.attempts++
+
+	if fbc.attempts <= fbc.failures {
+		return errBroadcastFailed
+	}
+
+	return fbc.BroadcastChannel.Send(ctx, message, strategy...)
+}
+
 // setupSigningDoneCheck sets up an instance of the signing done check ready
 // to perform test checks.
 func setupSigningDoneCheck(
@@ -17,6 +17,7 @@ import (
 	"github.com/keep-network/keep-core/pkg/operator"
 	"github.com/keep-network/keep-core/pkg/protocol/group"
 	"github.com/keep-network/keep-core/pkg/tecdsa"
+	"golang.org/x/time/rate"
 )
 
 func TestSigningExecutor_Sign(t *testing.T) {
@@ -107,9 +108,107 @@ func TestSigningExecutor_SignBatch(t *testing.T) {
 	}
 }
 
+func TestSigningExecutor_RateLimit(t *testing.T) {
+	executor := setupSigningExecutor(t)
+
+	rateWindow := 500 * time.Millisecond
+	executor.rateLimiter = rate.NewLimiter(rate.Every(rateWindow), 1)
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	// An empty batch is admitted by the rate limiter but does not trigger
+	// any signing so, it can be used to consume the limit cheaply.
+	_, err := executor.signBatch(ctx, []*big.Int{}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = executor.signBatch(ctx, []*big.Int{}, 0)
+	testutils.AssertErrorsSame(t, ErrSigningRateLimited, err)
+
+	_, _, _, err = executor.sign(ctx, big.NewInt(100), 0)
+	testutils.AssertErrorsSame(t, ErrSigningRateLimited, err)
+
+	testutils.AssertUintsEqual(
+		t,
+		"rate limited requests",
+		2,
+		executor.rateLimitedRequestsTotal(),
+	)
+
+	// Wait until the rate window elapses.
+	time.Sleep(rateWindow)
+
+	_, err = executor.signBatch(ctx, []*big.Int{}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: [%v]", err)
+	}
+
+	testutils.AssertUintsEqual(
+		t,
+		"rate limited requests",
+		2,
+		executor.rateLimitedRequestsTotal(),
+	)
+}
+
+func TestSigningExecutor_RateLimit_Busy(t *testing.T) {
+	executor := setupSigningExecutor(t)
+
+	executor.rateLimiter = rate.NewLimiter(rate.Every(time.Hour), 1)
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+
+	// Simulate the executor busy with another signing.
+	if !executor.lock.TryAcquire(1) {
+		t.Fatal("cannot acquire the signing executor lock")
+	}
+
+	_, err := executor.signBatch(ctx, []*big.Int{}, 0)
+	testutils.AssertErrorsSame(t, errSigningExecutorBusy, err)
+
+	executor.lock.Release(1)
+
+	testutils.AssertUintsEqual(
+		t,
+		"rate limited requests",
+		0,
+		executor.rateLimitedRequestsTotal(),
+	)
+
+	// The request rejected by the busy executor must not consume the limit.
+	_, err = executor.signBatch(ctx, []*big.Int{}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: [%v]", err)
+	}
+}
+
 // setupSigningExecutor sets up an instance of the signing executor ready
 // to perform test signing.
 func setupSigningExecutor(t *testing.T) *signingExecutor {
+	node, _, walletPublicKey := setupSigningNode(t)
+
+	executor, ok, err := node.getSigningExecutor(walletPublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("node is supposed to control wallet signers")
+	}
+
+	// Test block counter is much quicker than the real world one.
+	// Set more attempts to give more time for computations.
+	executor.signingAttemptsLimit *= 8
+
+	return executor
+}
+
+// setupSigningNode sets up an instance of the node controlling all signers
+// of a test wallet. It returns the node, the underlying local chain, and
+// the public key of the test wallet.
+func setupSigningNode(t *testing.T) (*node, *localChain, *ecdsa.PublicKey) {
 	groupParameters := &GroupParameters{
 		GroupSize:       5,
 		GroupQuorum:     4,
@@ -191,17 +290,5 @@ func setupSigningExecutor(t *testing.T) *signingExecutor {
 		t.Fatal(err)
 	}
 
-	executor, ok, err := node.getSigningExecutor(signers[0].wallet.publicKey)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if !ok {
-		t.Fatal("node is supposed to control wallet signers")
-	}
-
-	// Test block counter is much quicker than the real world one.
-	// Set more attempts to give more time for computations.
-	executor.signingAttemptsLimit *= 8
-
-	return executor
+	return node, localChain, signers[0].wallet.publicKey
 }
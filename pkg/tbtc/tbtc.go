@@ -3,9 +3,13 @@ package tbtc
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 	"runtime"
 	"time"
 
+	"gopkg.in/yaml.v3"
+
 	"github.com/keep-network/keep-core/pkg/bitcoin"
 
 	"github.com/ipfs/go-log"
@@ -15,6 +19,7 @@ import (
 	"github.com/keep-network/keep-core/pkg/generator"
 	"github.com/keep-network/keep-core/pkg/net"
 	"github.com/keep-network/keep-core/pkg/sortition"
+	"github.com/keep-network/keep-core/pkg/tecdsa/dkg"
 )
 
 // TODO: Unit tests for `tbtc.go`.
@@ -49,6 +54,10 @@ const (
 	DefaultPreParamsGenerationTimeout     = 2 * time.Minute
 	DefaultPreParamsGenerationDelay       = 10 * time.Second
 	DefaultPreParamsGenerationConcurrency = 1
+	DefaultSigningRateLimit               = 1
+	DefaultMaxGasPriceGwei                = 500
+	DefaultMaxDKGAttempts                 = 1
+	DefaultSignerHealthCheckInterval      = 24 * time.Hour
 )
 
 var DefaultKeyGenerationConcurrency = runtime.GOMAXPROCS(0)
@@ -56,15 +65,120 @@ var DefaultKeyGenerationConcurrency = runtime.GOMAXPROCS(0)
 // Config carries the config for tBTC protocol.
 type Config struct {
 	// The size of the pre-parameters pool for tECDSA.
-	PreParamsPoolSize int
+	PreParamsPoolSize int `yaml:"preParamsPoolSize"`
 	// Timeout for pre-parameters generation for tECDSA.
-	PreParamsGenerationTimeout time.Duration
+	PreParamsGenerationTimeout time.Duration `yaml:"preParamsGenerationTimeout"`
 	// The delay between generating new pre-params for tECDSA.
-	PreParamsGenerationDelay time.Duration
+	PreParamsGenerationDelay time.Duration `yaml:"preParamsGenerationDelay"`
 	// Concurrency level for pre-parameters generation for tECDSA.
-	PreParamsGenerationConcurrency int
+	PreParamsGenerationConcurrency int `yaml:"preParamsGenerationConcurrency"`
 	// Concurrency level for key-generation for tECDSA.
-	KeyGenerationConcurrency int
+	KeyGenerationConcurrency int `yaml:"keyGenerationConcurrency"`
+	// The maximum number of signing requests per second accepted by the
+	// signing executor of a single wallet. If not set, the
+	// DefaultSigningRateLimit is used.
+	SigningRateLimit float64 `yaml:"signingRateLimit"`
+	// The maximum gas price, in Gwei, the client is willing to pay when
+	// submitting the DKG result. If not set, the DefaultMaxGasPriceGwei
+	// is used.
+	MaxGasPriceGwei uint64 `yaml:"maxGasPriceGwei"`
+	// The maximum number of attempts to execute the DKG protocol before
+	// aborting it. Zero means no limit; attempts are then bounded only by
+	// the DKG timeout.
+	MaxDKGAttempts uint `yaml:"maxDkgAttempts"`
+	// The maximum time the readiness announcement of a DKG attempt waits
+	// for other group members. Once it elapses, the attempt proceeds with
+	// members that announced their readiness so far. It is meant to bound
+	// the announcement if blocks are not mined so, it must not be shorter
+	// than the announcement phase of an attempt. If not set, the
+	// announcement lasts until the announcement end block, as for all
+	// other group members.
+	DKGAnnouncerTimeout time.Duration `yaml:"dkgAnnouncerTimeout"`
+	// The interval of checks verifying the persisted key shares of the
+	// client's signers match their in-memory copies. If not set, the
+	// DefaultSignerHealthCheckInterval is used.
+	SignerHealthCheckInterval time.Duration `yaml:"signerHealthCheckInterval"`
+}
+
+// LoadFromFile reads the tBTC config from the YAML file under the given path.
+// Fields missing in the file are set to their default values. The file must
+// not contain any fields that are not part of the config. The loaded config
+// is validated before being returned.
+func LoadFromFile(path string) (Config, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("cannot open config file: [%v]", err)
+	}
+	defer file.Close()
+
+	config := Config{
+		PreParamsPoolSize:              DefaultPreParamsPoolSize,
+		PreParamsGenerationTimeout:     DefaultPreParamsGenerationTimeout,
+		PreParamsGenerationDelay:       DefaultPreParamsGenerationDelay,
+		PreParamsGenerationConcurrency: DefaultPreParamsGenerationConcurrency,
+		KeyGenerationConcurrency:       DefaultKeyGenerationConcurrency,
+		SigningRateLimit:               DefaultSigningRateLimit,
+		MaxGasPriceGwei:                DefaultMaxGasPriceGwei,
+		MaxDKGAttempts:                 DefaultMaxDKGAttempts,
+		SignerHealthCheckInterval:      DefaultSignerHealthCheckInterval,
+	}
+
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(&config); err != nil && err != io.EOF {
+		return Config{}, fmt.Errorf("cannot parse config file: [%v]", err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return Config{}, fmt.Errorf("invalid config: [%w]", err)
+	}
+
+	return config, nil
+}
+
+// Validate checks the config values against the resources of the host
+// machine. It returns an error if a value is not acceptable and logs
+// a warning if a value is acceptable but likely to degrade performance.
+func (c *Config) Validate() error {
+	return c.validate(runtime.NumCPU(), logger)
+}
+
+func (c *Config) validate(cpuCount int, logger log.StandardLogger) error {
+	// Key generation is CPU-bound so running more concurrent computations
+	// than available CPUs causes contention. Slight oversubscription is
+	// tolerated but anything beyond twice the CPU count is rejected.
+	if c.KeyGenerationConcurrency > 2*cpuCount {
+		return fmt.Errorf(
+			"key generation concurrency [%v] exceeds twice the CPU count [%v]",
+			c.KeyGenerationConcurrency,
+			cpuCount,
+		)
+	}
+
+	if c.KeyGenerationConcurrency > cpuCount {
+		logger.Warnf(
+			"key generation concurrency [%v] exceeds the CPU count [%v]; "+
+				"this may cause CPU contention during key generation",
+			c.KeyGenerationConcurrency,
+			cpuCount,
+		)
+	}
+
+	// The announcement end block keeps all group members in sync. A timeout
+	// ending the announcement earlier would let each member select
+	// attempt participants from a different set of ready members.
+	if c.DKGAnnouncerTimeout > 0 &&
+		c.DKGAnnouncerTimeout < dkgAnnouncerMinimumTimeout {
+		return fmt.Errorf(
+			"DKG announcer timeout [%v] is shorter than the announcement "+
+				"phase [%v]",
+			c.DKGAnnouncerTimeout,
+			dkgAnnouncerMinimumTimeout,
+		)
+	}
+
+	return nil
 }
 
 // Initialize kicks off the TBTC by initializing internal state, ensuring
@@ -82,6 +196,10 @@ func Initialize(
 	config Config,
 	clientInfo *clientinfo.Registry,
 ) error {
+	if err := config.Validate(); err != nil {
+		return fmt.Errorf("invalid TBTC config: [%v]", err)
+	}
+
 	groupParameters := &GroupParameters{
 		GroupSize:       100,
 		GroupQuorum:     90,
@@ -112,12 +230,40 @@ func Initialize(
 
 	if clientInfo != nil {
 		// only if client info endpoint is configured
+		clientInfo.ObserveApplicationSource(
+			"tbtc",
+			preParamsAgeSources(node.dkgExecutor),
+		)
+
+		clientInfo.ObserveApplicationSource(
+			"tbtc",
+			dkgPhaseDurationSources(node.dkgExecutor),
+		)
+
 		clientInfo.ObserveApplicationSource(
 			"tbtc",
 			map[string]clientinfo.Source{
 				"pre_params_count": func() float64 {
 					return float64(node.dkgExecutor.preParamsCount())
 				},
+				"dkg_retry_attempts_total": func() float64 {
+					return float64(node.dkgExecutor.retryAttemptsTotal())
+				},
+				"signing_rate_limited_total": func() float64 {
+					return float64(node.signingRateLimitedTotal())
+				},
+				"active_signers": func() float64 {
+					return float64(node.walletRegistry.Len())
+				},
+			},
+		)
+
+		clientInfo.RegisterApplicationSource(
+			"tbtc",
+			func() clientinfo.ApplicationInfo {
+				return clientinfo.ApplicationInfo{
+					"node_status": node.status(),
+				}
 			},
 		)
 	}
@@ -142,8 +288,8 @@ func Initialize(
 		)
 	}
 
-	_ = chain.OnDKGStarted(func(event *DKGStartedEvent) {
-		go func() {
+	handleDKGStarted := func(event *DKGStartedEvent) {
+		node.goroutineTracker.launch("dkg_started", func() {
 			if ok := deduplicator.notifyDKGStarted(
 				event.Seed,
 			); !ok {
@@ -207,17 +353,24 @@ func Initialize(
 					lastEvent.BlockNumber,
 				)
 
+				delayBlocks, err := node.dkgDelayBlocks(lastEvent.BlockNumber)
+				if err != nil {
+					logger.Errorf("failed to determine DKG delay: [%v]", err)
+					return
+				}
+
 				// The off-chain protocol should be started as close as possible
 				// to the current block or even further. Starting the off-chain
 				// protocol with a past block will likely cause a failure of the
 				// first attempt as the start block is used to synchronize
 				// the announcements and the state machine. Here we ensure
 				// a proper start point by delaying the execution by the
-				// confirmation period length.
+				// confirmation period length. The execution is delayed further
+				// if the beacon is busy generating a relay entry.
 				node.joinDKGIfEligible(
 					lastEvent.Seed,
 					lastEvent.BlockNumber,
-					dkgStartedConfirmationBlocks,
+					delayBlocks,
 				)
 			} else {
 				logger.Infof(
@@ -227,11 +380,21 @@ func Initialize(
 					event.BlockNumber,
 				)
 			}
-		}()
+		})
+	}
+
+	_ = chain.OnDKGStarted(handleDKGStarted)
+
+	// DKG started while the node was offline is not delivered by the
+	// subscription so, it must be fetched from the chain explicitly. It is
+	// handled the same way as DKG started events, including the confirmation
+	// and deduplication against the subscription.
+	node.goroutineTracker.launch("pending_dkg", func() {
+		joinPendingDKG(chain, node.dkgDelayBlocks, handleDKGStarted)
 	})
 
 	_ = chain.OnDKGResultSubmitted(func(event *DKGResultSubmittedEvent) {
-		go func() {
+		node.goroutineTracker.launch("dkg_result_submitted", func() {
 			if ok := deduplicator.notifyDKGResultSubmitted(
 				event.Seed,
 				event.ResultHash,
@@ -261,11 +424,113 @@ func Initialize(
 				event.Result,
 				event.ResultHash,
 			)
-		}()
+		})
+	})
+
+	node.goroutineTracker.launch("signer_health_check", func() {
+		node.periodicSignerHealthCheck(ctx)
+	})
+
+	// Wallet creations are synced in the background on startup and
+	// whenever a DKG result gets approved, i.e. a new wallet is registered.
+	node.goroutineTracker.launch("wallet_creations", func() {
+		currentBlock, err := getCurrentBlock(chain)
+		if err != nil {
+			logger.Errorf("cannot get current block: [%v]", err)
+			return
+		}
+
+		startBlock := uint64(0)
+		if currentBlock > walletCreationsLookBackBlocks {
+			startBlock = currentBlock - walletCreationsLookBackBlocks
+		}
+
+		err = node.syncWalletCreations(startBlock, nil)
+		if err != nil {
+			logger.Errorf("cannot sync wallet creations: [%v]", err)
+		}
+	})
+
+	_ = chain.OnDKGResultApproved(func(event *DKGResultApprovedEvent) {
+		node.goroutineTracker.launch("wallet_creations", func() {
+			blockNumber := event.BlockNumber
+
+			err := node.syncWalletCreations(blockNumber, &blockNumber)
+			if err != nil {
+				logger.Errorf("cannot sync wallet creations: [%v]", err)
+			}
+		})
 	})
 
+	_ = chain.OnMovingFundsTimedOut(func(event *MovingFundsTimedOutEvent) {
+		node.goroutineTracker.launch("moving_funds_timed_out", func() {
+			if ok := deduplicator.notifyMovingFundsTimedOut(
+				event.WalletPublicKeyHash,
+				event.BlockNumber,
+			); !ok {
+				logger.Warnf(
+					"Moving funds timeout for wallet PKH [0x%x] at block [%v] "+
+						"has been already processed",
+					event.WalletPublicKeyHash,
+					event.BlockNumber,
+				)
+				return
+			}
+
+			node.handleMovingFundsTimedOut(event)
+		})
+	})
+
+	_ = chain.OnDKGTimedOut(func(event *DKGTimedOutEvent) {
+		node.goroutineTracker.launch("dkg_timed_out", func() {
+			if ok := deduplicator.notifyDKGTimedOut(
+				event.Seed,
+				event.BlockNumber,
+			); !ok {
+				logger.Warnf(
+					"Timeout of DKG with seed [0x%x] at block [%v] "+
+						"has been already processed",
+					event.Seed,
+					event.BlockNumber,
+				)
+				return
+			}
+
+			node.handleDKGTimedOut(event)
+		})
+	})
+
+	_ = chain.OnFraudChallengeSubmitted(
+		func(event *FraudChallengeSubmittedEvent) {
+			node.goroutineTracker.launch("fraud_challenge_submitted", func() {
+				if ok := deduplicator.notifyFraudChallengeSubmitted(
+					event.WalletPublicKeyHash,
+					event.Sighash,
+				); !ok {
+					logger.Warnf(
+						"Fraud challenge against wallet PKH [0x%x] for "+
+							"sighash [0x%x] has been already processed",
+						event.WalletPublicKeyHash,
+						event.Sighash,
+					)
+					return
+				}
+
+				logger.Infof(
+					"Fraud challenge against wallet PKH [0x%x] for "+
+						"sighash [0x%x] submitted at block [%v]",
+					event.WalletPublicKeyHash,
+					event.Sighash,
+					event.BlockNumber,
+				)
+
+				node.handleFraudChallengeSubmitted(ctx, event)
+			})
+		},
+	)
+
 	_ = chain.OnWalletClosed(func(event *WalletClosedEvent) {
-		go func() {
+		node.goroutineTracker.launch("wallet_closed", func() {
 			if ok := deduplicator.notifyWalletClosed(
 				event.WalletID,
 			); !ok {
@@ -295,12 +560,72 @@ func Initialize(
 					err,
 				)
 			}
-		}()
+		})
 	})
 
 	return nil
 }
 
+// joinPendingDKG checks whether there is a DKG session awaiting the result
+// that has not timed out yet and, if so, passes it to the provided DKG
+// started handler. This allows to join a DKG that started while the node
+// was offline. The session is ignored if the off-chain protocol, delayed
+// by the number of blocks returned by delayBlocksFn, has already started
+// as the node could not catch up with the other group members anyway.
+func joinPendingDKG(
+	chain Chain,
+	delayBlocksFn func(startBlock uint64) (uint64, error),
+	handleFn func(event *DKGStartedEvent),
+) {
+	session, err := chain.GetPendingDKGSession()
+	if err != nil {
+		logger.Errorf("failed to get pending DKG session: [%v]", err)
+		return
+	}
+
+	if session == nil {
+		logger.Infof("no pending DKG session")
+		return
+	}
+
+	currentBlock, err := getCurrentBlock(chain)
+	if err != nil {
+		logger.Errorf("cannot get current block: [%v]", err)
+		return
+	}
+
+	delayBlocks, err := delayBlocksFn(session.StartBlock)
+	if err != nil {
+		logger.Errorf("failed to determine DKG delay: [%v]", err)
+		return
+	}
+
+	protocolStartBlock := session.StartBlock + delayBlocks
+	if currentBlock >= protocolStartBlock {
+		logger.Infof(
+			"pending DKG with seed [0x%x] started at block [%v] cannot be "+
+				"joined; off-chain protocol started at block [%v] and the "+
+				"current block is [%v]",
+			session.Seed,
+			session.StartBlock,
+			protocolStartBlock,
+			currentBlock,
+		)
+		return
+	}
+
+	logger.Infof(
+		"found pending DKG with seed [0x%x] started at block [%v]",
+		session.Seed,
+		session.StartBlock,
+	)
+
+	handleFn(&DKGStartedEvent{
+		Seed:        session.Seed,
+		BlockNumber: session.StartBlock,
+	})
+}
+
 // enoughPreParamsInPoolPolicy is a policy that enforces the sufficient size
 // of the DKG pre-parameters pool before joining the sortition pool.
 type enoughPreParamsInPoolPolicy struct {
@@ -313,3 +638,76 @@ func (eppip *enoughPreParamsInPoolPolicy) ShouldJoin() bool {
 	poolSize := eppip.config.PreParamsPoolSize
 	return paramsInPool >= poolSize
 }
+
+// durationHistogramSources returns client info sources exposing the duration
+// histogram returned by the given function. As the client info registry
+// supports gauges only, each histogram bucket is exposed as a separate gauge
+// named after the bucket's upper bound in seconds. Along with the buckets,
+// the total count and the total sum of observed durations are exposed.
+func durationHistogramSources(
+	name string,
+	buckets []time.Duration,
+	histogramFn func() *dkg.DurationHistogram,
+) map[string]clientinfo.Source {
+	sources := map[string]clientinfo.Source{
+		name + "_count": func() float64 {
+			return float64(histogramFn().Count)
+		},
+		name + "_sum": func() float64 {
+			return histogramFn().Sum.Seconds()
+		},
+	}
+
+	for i, upperBound := range buckets {
+		bucketIndex := i
+		bucketName := fmt.Sprintf(
+			"%s_bucket_le_%d",
+			name,
+			int64(upperBound.Seconds()),
+		)
+
+		sources[bucketName] = func() float64 {
+			return float64(histogramFn().BucketCounts[bucketIndex])
+		}
+	}
+
+	return sources
+}
+
+// preParamsAgeSources returns client info sources exposing the histogram of
+// the age of DKG pre-parameters at the time they were consumed.
+func preParamsAgeSources(
+	dkgExecutor *dkgExecutor,
+) map[string]clientinfo.Source {
+	return durationHistogramSources(
+		"dkg_preparams_age_seconds",
+		dkg.PreParamsAgeBuckets,
+		dkgExecutor.preParamsAgeHistogram,
+	)
+}
+
+// dkgPhaseDurationSources returns client info sources exposing histograms of
+// the duration of DKG protocol phases. Each phase is exposed as a separate
+// histogram named after the phase.
+func dkgPhaseDurationSources(
+	dkgExecutor *dkgExecutor,
+) map[string]clientinfo.Source {
+	sources := make(map[string]clientinfo.Source)
+
+	for _, phase := range dkg.ExecutionPhases {
+		phaseName := phase
+		phaseSources := durationHistogramSources(
+			fmt.Sprintf("dkg_phase_duration_seconds_%s", phaseName),
+			dkg.PhaseDurationBuckets,
+			func() *dkg.DurationHistogram {
+				return dkgExecutor.phaseDurationHistograms()[phaseName]
+			},
+		)
+
+		for sourceName, source := range phaseSources {
+			sources[sourceName] = source
+		}
+	}
+
+	return sources
+}
@@ -0,0 +1,292 @@
+package tbtc
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/internal/testutils"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	cpuCount := 4
+
+	var tests = map[string]struct {
+		keyGenerationConcurrency int
+		expectedWarning          bool
+		expectedErr              error
+	}{
+		"concurrency of 1": {
+			keyGenerationConcurrency: 1,
+			expectedWarning:          false,
+			expectedErr:              nil,
+		},
+		"concurrency equal to the CPU count": {
+			keyGenerationConcurrency: cpuCount,
+			expectedWarning:          false,
+			expectedErr:              nil,
+		},
+		"concurrency exceeding the CPU count": {
+			keyGenerationConcurrency: cpuCount + 1,
+			expectedWarning:          true,
+			expectedErr:              nil,
+		},
+		"concurrency equal to twice the CPU count": {
+			keyGenerationConcurrency: 2 * cpuCount,
+			expectedWarning:          true,
+			expectedErr:              nil,
+		},
+		"concurrency exceeding twice the CPU count": {
+			keyGenerationConcurrency: 2*cpuCount + 1,
+			expectedWarning:          false,
+			expectedErr: fmt.Errorf(
+				"key generation concurrency [9] exceeds twice the CPU count [4]",
+			),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			config := &Config{
+				KeyGenerationConcurrency: test.keyGenerationConcurrency,
+			}
+
+			logger := &warningsRecordingLogger{}
+
+			err := config.validate(cpuCount, logger)
+			if !reflect.DeepEqual(test.expectedErr, err) {
+				t.Errorf(
+					"unexpected error\nexpected: [%v]\nactual:   [%v]",
+					test.expectedErr,
+					err,
+				)
+			}
+
+			testutils.AssertBoolsEqual(
+				t,
+				"warning",
+				test.expectedWarning,
+				len(logger.warnings) > 0,
+			)
+		})
+	}
+}
+
+func TestConfig_Validate_DKGAnnouncerTimeout(t *testing.T) {
+	var tests = map[string]struct {
+		dkgAnnouncerTimeout time.Duration
+		expectedErr         error
+	}{
+		"timeout not set": {
+			dkgAnnouncerTimeout: 0,
+			expectedErr:         nil,
+		},
+		"timeout equal to the announcement phase": {
+			dkgAnnouncerTimeout: dkgAnnouncerMinimumTimeout,
+			expectedErr:         nil,
+		},
+		"timeout shorter than the announcement phase": {
+			dkgAnnouncerTimeout: 30 * time.Second,
+			expectedErr: fmt.Errorf(
+				"DKG announcer timeout [30s] is shorter than the " +
+					"announcement phase [2m0s]",
+			),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			config := &Config{
+				KeyGenerationConcurrency: 1,
+				DKGAnnouncerTimeout:      test.dkgAnnouncerTimeout,
+			}
+
+			err := config.validate(1, &warningsRecordingLogger{})
+			if !reflect.DeepEqual(test.expectedErr, err) {
+				t.Errorf(
+					"unexpected error\nexpected: [%v]\nactual:   [%v]",
+					test.expectedErr,
+					err,
+				)
+			}
+		})
+	}
+}
+
+func TestLoadFromFile(t *testing.T) {
+	path := writeConfigFile(
+		t,
+		`
+preParamsPoolSize: 50
+preParamsGenerationTimeout: 3m
+preParamsGenerationDelay: 15s
+keyGenerationConcurrency: 1
+signingRateLimit: 2.5
+maxGasPriceGwei: 250
+`,
+	)
+
+	config, err := LoadFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedConfig := Config{
+		PreParamsPoolSize:              50,
+		PreParamsGenerationTimeout:     3 * time.Minute,
+		PreParamsGenerationDelay:       15 * time.Second,
+		PreParamsGenerationConcurrency: DefaultPreParamsGenerationConcurrency,
+		KeyGenerationConcurrency:       1,
+		SigningRateLimit:               2.5,
+		MaxGasPriceGwei:                250,
+		MaxDKGAttempts:                 DefaultMaxDKGAttempts,
+		SignerHealthCheckInterval:      DefaultSignerHealthCheckInterval,
+	}
+
+	if !reflect.DeepEqual(expectedConfig, config) {
+		t.Errorf(
+			"unexpected config\nexpected: [%+v]\nactual:   [%+v]",
+			expectedConfig,
+			config,
+		)
+	}
+}
+
+func TestLoadFromFile_UnknownField(t *testing.T) {
+	path := writeConfigFile(
+		t,
+		`
+preParamsPoolSize: 50
+unknownField: 1
+`,
+	)
+
+	_, err := LoadFromFile(path)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if !strings.Contains(err.Error(), "field unknownField not found") {
+		t.Errorf("unexpected error: [%v]", err)
+	}
+}
+
+func TestLoadFromFile_InvalidConfig(t *testing.T) {
+	keyGenerationConcurrency := 2*runtime.NumCPU() + 1
+
+	path := writeConfigFile(
+		t,
+		fmt.Sprintf("keyGenerationConcurrency: %v\n", keyGenerationConcurrency),
+	)
+
+	_, err := LoadFromFile(path)
+
+	expectedErr := fmt.Errorf(
+		"key generation concurrency [%v] exceeds twice the CPU count [%v]",
+		keyGenerationConcurrency,
+		runtime.NumCPU(),
+	)
+	if !reflect.DeepEqual(expectedErr, errors.Unwrap(err)) {
+		t.Errorf(
+			"unexpected error\nexpected: [%v]\nactual:   [%v]",
+			expectedErr,
+			err,
+		)
+	}
+}
+
+func writeConfigFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "tbtc.yaml")
+
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+// warningsRecordingLogger is a logger that records all formatted warnings.
+type warningsRecordingLogger struct {
+	testutils.MockLogger
+
+	warnings []string
+}
+
+func (wrl *warningsRecordingLogger) Warnf(format string, args ...interface{}) {
+	wrl.warnings = append(wrl.warnings, fmt.Sprintf(format, args...))
+}
+
+func TestJoinPendingDKG(t *testing.T) {
+	var tests = map[string]struct {
+		pendingSession      *DKGSession
+		delayBlocks         uint64
+		expectedHandleCalls int
+	}{
+		"no pending session": {
+			pendingSession:      nil,
+			delayBlocks:         dkgStartedConfirmationBlocks,
+			expectedHandleCalls: 0,
+		},
+		"pending session": {
+			pendingSession: &DKGSession{
+				Seed:       big.NewInt(100),
+				StartBlock: 1000,
+			},
+			delayBlocks:         dkgStartedConfirmationBlocks,
+			expectedHandleCalls: 1,
+		},
+		"pending session with off-chain protocol already started": {
+			pendingSession: &DKGSession{
+				Seed:       big.NewInt(100),
+				StartBlock: 0,
+			},
+			delayBlocks:         0,
+			expectedHandleCalls: 0,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			localChain := Connect()
+			localChain.setPendingDKGSession(test.pendingSession)
+
+			handleCalls := 0
+			joinPendingDKG(
+				localChain,
+				func(startBlock uint64) (uint64, error) {
+					return test.delayBlocks, nil
+				},
+				func(event *DKGStartedEvent) {
+					handleCalls++
+
+					testutils.AssertBigIntsEqual(
+						t,
+						"seed",
+						test.pendingSession.Seed,
+						event.Seed,
+					)
+					testutils.AssertUintsEqual(
+						t,
+						"block number",
+						test.pendingSession.StartBlock,
+						event.BlockNumber,
+					)
+				},
+			)
+
+			testutils.AssertIntsEqual(
+				t,
+				"handle calls",
+				test.expectedHandleCalls,
+				handleCalls,
+			)
+		})
+	}
+}
@@ -690,13 +690,24 @@ type signer struct {
 	privateKeyShare *tecdsa.PrivateKeyShare
 }
 
-// newSigner constructs a new instance of the wallet's signer.
+// newSigner constructs a new instance of the wallet's signer. It returns an
+// error if the signing group member index is not within the
+// [1, len(walletSigningGroupOperators)] range.
 func newSigner(
 	walletPublicKey *ecdsa.PublicKey,
 	walletSigningGroupOperators []chain.Address,
 	signingGroupMemberIndex group.MemberIndex,
 	privateKeyShare *tecdsa.PrivateKeyShare,
-) *signer {
+) (*signer, error) {
+	if signingGroupMemberIndex < 1 ||
+		int(signingGroupMemberIndex) > len(walletSigningGroupOperators) {
+		return nil, fmt.Errorf(
+			"signing group member index [%v] is out of range [1, %v]",
+			signingGroupMemberIndex,
+			len(walletSigningGroupOperators),
+		)
+	}
+
 	wallet := wallet{
 		publicKey:             walletPublicKey,
 		signingGroupOperators: walletSigningGroupOperators,
@@ -706,7 +717,7 @@ func newSigner(
 		wallet:                  wallet,
 		signingGroupMemberIndex: signingGroupMemberIndex,
 		privateKeyShare:         privateKeyShare,
-	}
+	}, nil
 }
 
 func (s *signer) String() string {
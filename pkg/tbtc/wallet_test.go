@@ -473,3 +473,75 @@ func (mwse *mockWalletSigningExecutor) buildSignaturesKey(
 
 	return sha256.Sum256(buffer.Bytes())
 }
+
+func TestNewSigner(t *testing.T) {
+	operators := []chain.Address{"address-1", "address-2", "address-3"}
+
+	var tests = map[string]struct {
+		signingGroupMemberIndex group.MemberIndex
+		expectedErr             error
+	}{
+		"index equal to zero": {
+			signingGroupMemberIndex: 0,
+			expectedErr: fmt.Errorf(
+				"signing group member index [0] is out of range [1, 3]",
+			),
+		},
+		"index equal to one": {
+			signingGroupMemberIndex: 1,
+			expectedErr:             nil,
+		},
+		"index equal to the group size": {
+			signingGroupMemberIndex: 3,
+			expectedErr:             nil,
+		},
+		"index greater than the group size": {
+			signingGroupMemberIndex: 4,
+			expectedErr: fmt.Errorf(
+				"signing group member index [4] is out of range [1, 3]",
+			),
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			walletPublicKey := &ecdsa.PublicKey{
+				Curve: tecdsa.Curve,
+				X:     big.NewInt(1),
+				Y:     big.NewInt(2),
+			}
+
+			signer, err := newSigner(
+				walletPublicKey,
+				operators,
+				test.signingGroupMemberIndex,
+				nil,
+			)
+
+			if !reflect.DeepEqual(test.expectedErr, err) {
+				t.Errorf(
+					"unexpected error\nexpected: [%v]\nactual:   [%v]",
+					test.expectedErr,
+					err,
+				)
+			}
+
+			if test.expectedErr != nil {
+				return
+			}
+
+			testutils.AssertIntsEqual(
+				t,
+				"signing group member index",
+				int(test.signingGroupMemberIndex),
+				int(signer.signingGroupMemberIndex),
+			)
+			testutils.AssertIntsEqual(
+				t,
+				"signing group operators count",
+				len(operators),
+				len(signer.wallet.signingGroupOperators),
+			)
+		})
+	}
+}
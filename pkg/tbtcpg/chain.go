@@ -113,7 +113,9 @@ type Chain interface {
 	) error
 
 	// GetDepositSweepMaxSize gets the maximum number of deposits that can
-	// be part of a deposit sweep proposal.
+	// be part of a deposit sweep proposal. The value is a constant of the
+	// wallet proposal validator and is always greater than zero. An error
+	// is returned only if the value could not be fetched from the chain.
 	GetDepositSweepMaxSize() (uint16, error)
 
 	BlockCounter() (chain.BlockCounter, error)
@@ -98,6 +98,7 @@ type LocalChain struct {
 	operatorIDs                              map[chain.Address]uint32
 	redemptionDelays                         map[[32]byte]time.Duration
 	depositMinAge                            uint32
+	depositSweepMaxSize                      uint16
 }
 
 func NewLocalChain() *LocalChain {
@@ -961,7 +962,21 @@ func (lc *LocalChain) SetRedemptionRequestMinAge(redemptionRequestMinAge uint32)
 }
 
 func (lc *LocalChain) GetDepositSweepMaxSize() (uint16, error) {
-	panic("unsupported")
+	lc.mutex.Lock()
+	defer lc.mutex.Unlock()
+
+	if lc.depositSweepMaxSize == 0 {
+		return 0, fmt.Errorf("deposit sweep max size not set")
+	}
+
+	return lc.depositSweepMaxSize, nil
+}
+
+func (lc *LocalChain) SetDepositSweepMaxSize(depositSweepMaxSize uint16) {
+	lc.mutex.Lock()
+	defer lc.mutex.Unlock()
+
+	lc.depositSweepMaxSize = depositSweepMaxSize
 }
 
 func (lc *LocalChain) BlockCounter() (chain.BlockCounter, error) {
@@ -1,10 +1,14 @@
 package tbtcpg
 
 import (
+	"bytes"
+	"encoding/csv"
 	"fmt"
+	"io"
 	"math"
 	"math/big"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/ipfs/go-log/v2"
@@ -59,6 +63,7 @@ func (dst *DepositSweepTask) Run(request *tbtc.CoordinationProposalRequest) (
 		taskLogger,
 		walletPublicKeyHash,
 		depositSweepMaxSize,
+		0,
 	)
 	if err != nil {
 		return nil, false, fmt.Errorf(
@@ -77,6 +82,7 @@ func (dst *DepositSweepTask) Run(request *tbtc.CoordinationProposalRequest) (
 		walletPublicKeyHash,
 		deposits,
 		0,
+		0,
 	)
 	if err != nil {
 		return nil, false, fmt.Errorf(
@@ -110,7 +116,9 @@ type Deposit struct {
 	Confirmations       uint
 }
 
-// FindDeposits finds deposits according to the given criteria.
+// FindDeposits finds deposits according to the given criteria. Deposits
+// whose funding transactions have fewer than minConfirmations Bitcoin
+// confirmations are skipped. Zero minConfirmations disables that filter.
 func FindDeposits(
 	chain Chain,
 	btcChain bitcoin.Chain,
@@ -118,6 +126,7 @@ func FindDeposits(
 	maxNumberOfDeposits int,
 	skipSwept bool,
 	skipUnconfirmed bool,
+	minConfirmations uint,
 ) ([]*Deposit, error) {
 	return findDeposits(
 		logger,
@@ -127,6 +136,7 @@ func FindDeposits(
 		maxNumberOfDeposits,
 		skipSwept,
 		skipUnconfirmed,
+		minConfirmations,
 	)
 }
 
@@ -139,6 +149,7 @@ func findDeposits(
 	maxNumberOfDeposits int,
 	skipSwept bool,
 	skipUnconfirmed bool,
+	minConfirmations uint,
 ) ([]*Deposit, error) {
 	fnLogger.Infof("reading revealed deposits from chain")
 
@@ -230,6 +241,17 @@ func findDeposits(
 			)
 		}
 
+		if confirmations < minConfirmations {
+			fnLogger.Debugf(
+				"deposit [%s] funding transaction has fewer confirmations "+
+					"than requested: [%d/%d]",
+				depositKeyStr,
+				confirmations,
+				minConfirmations,
+			)
+			continue
+		}
+
 		if skipUnconfirmed && confirmations < tbtc.DepositSweepRequiredFundingTxConfirmations {
 			fnLogger.Debugf(
 				"deposit [%s] funding transaction doesn't have enough confirmations: [%d/%d]",
@@ -240,6 +262,28 @@ func findDeposits(
 			continue
 		}
 
+		if skipUnconfirmed && confirmations < bitcoin.CoinbaseMaturity {
+			fundingTx, err := btcChain.GetTransaction(event.FundingTxHash)
+			if err != nil {
+				fnLogger.Errorf(
+					"failed to get deposit [%s] funding transaction: [%v]",
+					depositKeyStr,
+					err,
+				)
+				continue
+			}
+
+			if fundingTx.IsCoinbase() {
+				fnLogger.Debugf(
+					"deposit [%s] funding transaction is an immature coinbase: [%d/%d]",
+					depositKeyStr,
+					confirmations,
+					bitcoin.CoinbaseMaturity,
+				)
+				continue
+			}
+		}
+
 		result = append(
 			result,
 			&Deposit{
@@ -260,19 +304,139 @@ func findDeposits(
 	return result, nil
 }
 
+// depositsCSVHeader is the header row of the CSV produced by ExportDepositsCSV.
+var depositsCSVHeader = []string{
+	"depositID",
+	"walletPubKeyHash",
+	"fundingTxHash",
+	"fundingOutputIndex",
+	"amount",
+	"confirmations",
+	"swept",
+	"revealBlock",
+}
+
+// ExportDepositsCSV writes the given deposits to the provided writer in the
+// CSV format. The first written row is a header. Amounts are expressed in BTC
+// and funding transaction hashes use the same byte order as Bitcoin explorers.
+func ExportDepositsCSV(deposits []*Deposit, w io.Writer) error {
+	csvWriter := csv.NewWriter(w)
+
+	if err := csvWriter.Write(depositsCSVHeader); err != nil {
+		return fmt.Errorf("failed to write header: [%w]", err)
+	}
+
+	for _, deposit := range deposits {
+		record := []string{
+			deposit.DepositKey,
+			hexutils.Encode(deposit.WalletPublicKeyHash[:]),
+			deposit.FundingTxHash.Hex(bitcoin.ReversedByteOrder),
+			strconv.FormatUint(uint64(deposit.FundingOutputIndex), 10),
+			strconv.FormatFloat(deposit.AmountBtc, 'f', 8, 64),
+			strconv.FormatUint(uint64(deposit.Confirmations), 10),
+			strconv.FormatBool(deposit.IsSwept),
+			strconv.FormatUint(deposit.RevealBlock, 10),
+		}
+
+		if err := csvWriter.Write(record); err != nil {
+			return fmt.Errorf(
+				"failed to write deposit [%s]: [%w]",
+				deposit.DepositKey,
+				err,
+			)
+		}
+	}
+
+	csvWriter.Flush()
+
+	if err := csvWriter.Error(); err != nil {
+		return fmt.Errorf("failed to flush the writer: [%w]", err)
+	}
+
+	return nil
+}
+
+// WalletDepositSummary holds aggregated statistics of deposits revealed
+// to a single wallet.
+type WalletDepositSummary struct {
+	WalletPublicKeyHash [20]byte
+	// TotalAmountBtc is the total value of all deposits, in BTC.
+	TotalAmountBtc float64
+	// UnsweptAmountBtc is the total value of deposits not swept yet, in BTC.
+	UnsweptAmountBtc float64
+	DepositsCount    int
+	SweptCount       int
+	// OldestUnsweptDeposit is the unswept deposit with the lowest reveal
+	// block. It is nil if all deposits of the wallet are swept.
+	OldestUnsweptDeposit *Deposit
+}
+
+// GroupDepositsByWallet aggregates the given deposits per wallet. Summaries
+// are sorted by the unswept value in descending order. Wallets with the same
+// unswept value are sorted by their public key hashes to keep the order
+// deterministic.
+func GroupDepositsByWallet(deposits []*Deposit) []*WalletDepositSummary {
+	summariesByWallet := make(map[[20]byte]*WalletDepositSummary)
+	summaries := make([]*WalletDepositSummary, 0)
+
+	for _, deposit := range deposits {
+		summary, ok := summariesByWallet[deposit.WalletPublicKeyHash]
+		if !ok {
+			summary = &WalletDepositSummary{
+				WalletPublicKeyHash: deposit.WalletPublicKeyHash,
+			}
+			summariesByWallet[deposit.WalletPublicKeyHash] = summary
+			summaries = append(summaries, summary)
+		}
+
+		summary.DepositsCount++
+		summary.TotalAmountBtc += deposit.AmountBtc
+
+		if deposit.IsSwept {
+			summary.SweptCount++
+			continue
+		}
+
+		summary.UnsweptAmountBtc += deposit.AmountBtc
+
+		if summary.OldestUnsweptDeposit == nil ||
+			deposit.RevealBlock < summary.OldestUnsweptDeposit.RevealBlock {
+			summary.OldestUnsweptDeposit = deposit
+		}
+	}
+
+	sort.SliceStable(summaries, func(i, j int) bool {
+		if summaries[i].UnsweptAmountBtc != summaries[j].UnsweptAmountBtc {
+			return summaries[i].UnsweptAmountBtc > summaries[j].UnsweptAmountBtc
+		}
+
+		return bytes.Compare(
+			summaries[i].WalletPublicKeyHash[:],
+			summaries[j].WalletPublicKeyHash[:],
+		) < 0
+	})
+
+	return summaries
+}
+
 // FindDepositsToSweep finds deposits that can be swept.
 // maxNumberOfDeposits is used as a ceiling for the number of deposits in the
 // result. If number of discovered deposits meets the maxNumberOfDeposits the
 // function will stop fetching more deposits.
 // This function will return a list of deposits from the wallet that can be swept.
 // Deposits with insufficient number of funding transaction confirmations will
-// not be taken into consideration for sweeping.
+// not be taken into consideration for sweeping. The same applies to deposits
+// funded by coinbase transactions that have not reached the coinbase maturity.
+// The minConfirmations parameter can be used to require more funding
+// transaction confirmations than tbtc.DepositSweepRequiredFundingTxConfirmations.
+// Lower values, including zero, have no effect.
 //
 // TODO: Cache immutable data
 func (dst *DepositSweepTask) FindDepositsToSweep(
 	taskLogger log.StandardLogger,
 	walletPublicKeyHash [20]byte,
 	maxNumberOfDeposits uint16,
+	minConfirmations uint,
 ) ([]*DepositReference, error) {
 	if walletPublicKeyHash == [20]byte{} {
 		return nil, fmt.Errorf("wallet public key hash is required")
@@ -288,6 +452,7 @@ func (dst *DepositSweepTask) FindDepositsToSweep(
 		int(maxNumberOfDeposits),
 		true,
 		true,
+		minConfirmations,
 	)
 	if err != nil {
 		return nil, err
@@ -328,12 +493,15 @@ func (dst *DepositSweepTask) FindDepositsToSweep(
 	return depositsRefs, nil
 }
 
-// ProposeDepositsSweep returns a deposit sweep proposal.
+// ProposeDepositsSweep returns a deposit sweep proposal. If the fee is not
+// positive, the sweep transaction fee is estimated. If the maxFee is positive,
+// the proposal is aborted when the sweep transaction fee exceeds it.
 func (dst *DepositSweepTask) ProposeDepositsSweep(
 	taskLogger log.StandardLogger,
 	walletPublicKeyHash [20]byte,
 	deposits []*DepositReference,
 	fee int64,
+	maxFee int64,
 ) (*tbtc.DepositSweepProposal, error) {
 	if len(deposits) == 0 {
 		return nil, fmt.Errorf("deposits list is empty")
@@ -354,6 +522,7 @@ func (dst *DepositSweepTask) ProposeDepositsSweep(
 			dst.btcChain,
 			len(deposits),
 			perDepositMaxFee,
+			true,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("cannot estimate sweep transaction fee: [%v]", err)
@@ -364,6 +533,14 @@ func (dst *DepositSweepTask) ProposeDepositsSweep(
 
 	taskLogger.Infof("sweep transaction fee: [%d]", fee)
 
+	if maxFee > 0 && fee > maxFee {
+		return nil, fmt.Errorf(
+			"sweep transaction fee [%d] exceeds the maximum fee [%d]",
+			fee,
+			maxFee,
+		)
+	}
+
 	depositsKeys := make([]struct {
 		FundingTxHash      bitcoin.Hash
 		FundingOutputIndex uint32
@@ -411,11 +588,16 @@ func (dst *DepositSweepTask) ProposeDepositsSweep(
 // maximum count allowed by the WalletProposalValidator contract. Computed fees for
 // specific deposits counts are returned as a map.
 //
+// If the provided walletPublicKeyHash is set, the wallet's main UTXO is
+// determined and taken into account only if the wallet has one. Otherwise,
+// the wallet is assumed to have a main UTXO.
+//
 // While making estimations, this function assumes a sweep transaction
 // consists of:
-//   - 1 P2WPKH input being the current wallet main UTXO. That means the produced
-//     fees may be overestimated for the very first sweep transaction of
-//     each wallet.
+//   - 1 P2WPKH input being the current wallet main UTXO, unless the given
+//     wallet has no main UTXO yet. That means the produced fees may be
+//     overestimated for the very first sweep transaction of each wallet if
+//     the wallet is not provided.
 //   - N P2WSH inputs representing the deposits. Worth noting that real
 //     transactions may contain legacy P2SH deposits as well so produced fees may
 //     be underestimated in some rare cases.
@@ -426,6 +608,7 @@ func (dst *DepositSweepTask) ProposeDepositsSweep(
 func EstimateDepositsSweepFee(
 	chain Chain,
 	btcChain bitcoin.Chain,
+	walletPublicKeyHash [20]byte,
 	depositsCount int,
 ) (
 	map[int]struct {
@@ -439,6 +622,20 @@ func EstimateDepositsSweepFee(
 		return nil, fmt.Errorf("cannot get deposit tx max fee: [%v]", err)
 	}
 
+	hasMainUtxo := true
+	if walletPublicKeyHash != [20]byte{} {
+		walletMainUtxo, err := tbtc.DetermineWalletMainUtxo(
+			walletPublicKeyHash,
+			chain,
+			btcChain,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get wallet's main UTXO: [%v]", err)
+		}
+
+		hasMainUtxo = walletMainUtxo != nil
+	}
+
 	fees := make(map[int]struct {
 		TotalFee       int64
 		SatPerVByteFee int64
@@ -450,7 +647,7 @@ func EstimateDepositsSweepFee(
 	} else {
 		sweepMaxSize, err := chain.GetDepositSweepMaxSize()
 		if err != nil {
-			return nil, fmt.Errorf("cannot get sweep max size: [%v]", sweepMaxSize)
+			return nil, fmt.Errorf("cannot get sweep max size: [%v]", err)
 		}
 
 		for i := 1; i <= int(sweepMaxSize); i++ {
@@ -463,6 +660,7 @@ func EstimateDepositsSweepFee(
 			btcChain,
 			depositsCountKey,
 			perDepositMaxFee,
+			hasMainUtxo,
 		)
 		if err != nil {
 			return nil, fmt.Errorf(
@@ -488,10 +686,16 @@ func estimateDepositsSweepFee(
 	btcChain bitcoin.Chain,
 	depositsCount int,
 	perDepositMaxFee uint64,
+	hasMainUtxo bool,
 ) (int64, int64, error) {
-	transactionSize, err := bitcoin.NewTransactionSizeEstimator().
+	sizeEstimator := bitcoin.NewTransactionSizeEstimator()
+
+	if hasMainUtxo {
 		// 1 P2WPKH main UTXO input.
-		AddPublicKeyHashInputs(1, true).
+		sizeEstimator = sizeEstimator.AddPublicKeyHashInputs(1, true)
+	}
+
+	transactionSize, err := sizeEstimator.
 		// depositsCount P2WSH deposit inputs.
 		AddScriptHashInputs(depositsCount, depositScriptByteSize, true).
 		// 1 P2WPKH output.
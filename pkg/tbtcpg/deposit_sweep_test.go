@@ -1,11 +1,17 @@
 package tbtcpg_test
 
 import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
 	"reflect"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/go-test/deep"
 	"github.com/ipfs/go-log"
+	"github.com/keep-network/keep-core/internal/hexutils"
 	"github.com/keep-network/keep-core/internal/testutils"
 	"github.com/keep-network/keep-core/pkg/bitcoin"
 	"github.com/keep-network/keep-core/pkg/tbtc"
@@ -68,6 +74,7 @@ func TestDepositSweepTask_FindDepositsToSweep(t *testing.T) {
 				&testutils.MockLogger{},
 				scenario.WalletPublicKeyHash,
 				scenario.MaxNumberOfDeposits,
+				0,
 			)
 
 			if err != nil {
@@ -84,6 +91,116 @@ func TestDepositSweepTask_FindDepositsToSweep(t *testing.T) {
 	}
 }
 
+func TestDepositSweepTask_FindDepositsToSweep_MinConfirmations(t *testing.T) {
+	walletPublicKeyHash := [20]byte{0x01}
+
+	tbtcChain := tbtcpg.NewLocalChain()
+	btcChain := tbtcpg.NewLocalBitcoinChain()
+
+	for i, confirmations := range []uint{
+		tbtc.DepositSweepRequiredFundingTxConfirmations - 1,
+		tbtc.DepositSweepRequiredFundingTxConfirmations,
+		tbtc.DepositSweepRequiredFundingTxConfirmations + 4,
+	} {
+		fundingTxHash := bitcoin.Hash{byte(i + 1)}
+
+		tbtcChain.SetDepositRequest(
+			fundingTxHash,
+			0,
+			&tbtc.DepositChainRequest{
+				Amount:     100000,
+				RevealedAt: time.Now().Add(-time.Hour),
+				SweptAt:    time.Unix(0, 0),
+			},
+		)
+		// Funding transactions are not coinbase ones.
+		btcChain.SetTransaction(fundingTxHash, &bitcoin.Transaction{})
+		btcChain.SetTransactionConfirmations(fundingTxHash, confirmations)
+
+		err := tbtcChain.AddPastDepositRevealedEvent(
+			&tbtc.DepositRevealedEventFilter{
+				WalletPublicKeyHash: [][20]byte{walletPublicKeyHash},
+			},
+			&tbtc.DepositRevealedEvent{
+				BlockNumber:         uint64(i + 1),
+				WalletPublicKeyHash: walletPublicKeyHash,
+				FundingTxHash:       fundingTxHash,
+				FundingOutputIndex:  0,
+			},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	depositReference := func(i int) *tbtcpg.DepositReference {
+		return &tbtcpg.DepositReference{
+			FundingTxHash:      bitcoin.Hash{byte(i)},
+			FundingOutputIndex: 0,
+			RevealBlock:        uint64(i),
+		}
+	}
+
+	var tests = map[string]struct {
+		minConfirmations uint
+		expectedDeposits []*tbtcpg.DepositReference
+	}{
+		"threshold not set": {
+			minConfirmations: 0,
+			expectedDeposits: []*tbtcpg.DepositReference{
+				depositReference(2),
+				depositReference(3),
+			},
+		},
+		"threshold below the required confirmations": {
+			minConfirmations: tbtc.DepositSweepRequiredFundingTxConfirmations - 3,
+			expectedDeposits: []*tbtcpg.DepositReference{
+				depositReference(2),
+				depositReference(3),
+			},
+		},
+		"threshold above the required confirmations": {
+			minConfirmations: tbtc.DepositSweepRequiredFundingTxConfirmations + 1,
+			expectedDeposits: []*tbtcpg.DepositReference{
+				depositReference(3),
+			},
+		},
+		"threshold equal to the highest confirmations count": {
+			minConfirmations: tbtc.DepositSweepRequiredFundingTxConfirmations + 4,
+			expectedDeposits: []*tbtcpg.DepositReference{
+				depositReference(3),
+			},
+		},
+		"threshold excluding all deposits": {
+			minConfirmations: tbtc.DepositSweepRequiredFundingTxConfirmations + 5,
+			expectedDeposits: nil,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			task := tbtcpg.NewDepositSweepTask(tbtcChain, btcChain)
+
+			actualDeposits, err := task.FindDepositsToSweep(
+				&testutils.MockLogger{},
+				walletPublicKeyHash,
+				10,
+				test.minConfirmations,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := deep.Equal(
+				test.expectedDeposits,
+				actualDeposits,
+			); diff != nil {
+				t.Errorf("invalid deposits: %v", diff)
+			}
+		})
+	}
+}
+
 func TestDepositSweepTask_ProposeDepositsSweep(t *testing.T) {
 	err := log.SetLogLevel("*", "DEBUG")
 	if err != nil {
@@ -155,6 +272,7 @@ func TestDepositSweepTask_ProposeDepositsSweep(t *testing.T) {
 				scenario.WalletPublicKeyHash,
 				scenario.DepositsReferences(),
 				scenario.SweepTxFee,
+				scenario.MaxSweepTxFee,
 			)
 
 			if !reflect.DeepEqual(scenario.ExpectedErr, err) {
@@ -186,3 +304,461 @@ func TestDepositSweepTask_ProposeDepositsSweep(t *testing.T) {
 		})
 	}
 }
+
+func TestEstimateDepositsSweepFee(t *testing.T) {
+	tbtcChain := tbtcpg.NewLocalChain()
+	tbtcChain.SetDepositParameters(0, 0, 10000, 0)
+	tbtcChain.SetDepositSweepMaxSize(3)
+
+	btcChain := tbtcpg.NewLocalBitcoinChain()
+	btcChain.SetEstimateSatPerVByteFee(1, 10)
+
+	var tests = map[string]struct {
+		depositsCount          int
+		expectedDepositsCounts []int
+	}{
+		"specific deposits count": {
+			depositsCount:          2,
+			expectedDepositsCounts: []int{2},
+		},
+		"all deposits counts up to the sweep max size": {
+			depositsCount:          0,
+			expectedDepositsCounts: []int{1, 2, 3},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			fees, err := tbtcpg.EstimateDepositsSweepFee(
+				tbtcChain,
+				btcChain,
+				[20]byte{},
+				test.depositsCount,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actualDepositsCounts := make([]int, 0, len(fees))
+			for depositsCount, fee := range fees {
+				actualDepositsCounts = append(actualDepositsCounts, depositsCount)
+
+				testutils.AssertIntsEqual(
+					t,
+					fmt.Sprintf("sat/vbyte fee for [%v] deposits", depositsCount),
+					10,
+					int(fee.SatPerVByteFee),
+				)
+			}
+			sort.Ints(actualDepositsCounts)
+
+			if !reflect.DeepEqual(
+				test.expectedDepositsCounts,
+				actualDepositsCounts,
+			) {
+				t.Errorf(
+					"unexpected deposits counts\nexpected: %v\nactual:   %v",
+					test.expectedDepositsCounts,
+					actualDepositsCounts,
+				)
+			}
+		})
+	}
+}
+
+func TestEstimateDepositsSweepFee_WalletWithoutMainUtxo(t *testing.T) {
+	walletPublicKeyHash := [20]byte{0x01, 0x02}
+
+	tbtcChain := tbtcpg.NewLocalChain()
+	tbtcChain.SetDepositParameters(0, 0, 10000, 0)
+	// The wallet did not perform any sweep yet so, it has no main UTXO.
+	tbtcChain.SetWallet(walletPublicKeyHash, &tbtc.WalletChainData{
+		MainUtxoHash: [32]byte{},
+	})
+
+	btcChain := tbtcpg.NewLocalBitcoinChain()
+	btcChain.SetEstimateSatPerVByteFee(1, 10)
+
+	mainUtxoAssumedFees, err := tbtcpg.EstimateDepositsSweepFee(
+		tbtcChain,
+		btcChain,
+		[20]byte{},
+		2,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	firstSweepFees, err := tbtcpg.EstimateDepositsSweepFee(
+		tbtcChain,
+		btcChain,
+		walletPublicKeyHash,
+		2,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Skipping the missing main UTXO input should lower the total fee by
+	// the virtual size of a single P2WPKH input, i.e. 68 vbytes, multiplied
+	// by the sat/vbyte fee.
+	testutils.AssertIntsEqual(
+		t,
+		"total fee difference",
+		680,
+		int(mainUtxoAssumedFees[2].TotalFee-firstSweepFees[2].TotalFee),
+	)
+}
+
+func TestExportDepositsCSV(t *testing.T) {
+	fundingTxHash, err := bitcoin.NewHashFromString(
+		"2a5d5f472e376dc28964e1b597b1ca5ee5ac042101b5199a3ca8dae2deec3538",
+		bitcoin.ReversedByteOrder,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deposits := []*tbtcpg.Deposit{
+		{
+			DepositReference: tbtcpg.DepositReference{
+				FundingTxHash:      fundingTxHash,
+				FundingOutputIndex: 1,
+				RevealBlock:        100,
+			},
+			WalletPublicKeyHash: [20]byte{0x01, 0x02},
+			DepositKey:          "0xdeadbeef",
+			IsSwept:             true,
+			AmountBtc:           0.0125,
+			Confirmations:       6,
+		},
+		{
+			DepositReference: tbtcpg.DepositReference{
+				FundingTxHash:      fundingTxHash,
+				FundingOutputIndex: 2,
+				RevealBlock:        200,
+			},
+			WalletPublicKeyHash: [20]byte{0x03},
+			// Contains the delimiter and a quote to verify escaping.
+			DepositKey:    "key,with \"special\" characters",
+			IsSwept:       false,
+			AmountBtc:     1,
+			Confirmations: 0,
+		},
+	}
+
+	var buffer bytes.Buffer
+	if err := tbtcpg.ExportDepositsCSV(deposits, &buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedOutput := "" +
+		"depositID,walletPubKeyHash,fundingTxHash,fundingOutputIndex," +
+		"amount,confirmations,swept,revealBlock\n" +
+		"0xdeadbeef,0x0102000000000000000000000000000000000000," +
+		"2a5d5f472e376dc28964e1b597b1ca5ee5ac042101b5199a3ca8dae2deec3538," +
+		"1,0.01250000,6,true,100\n" +
+		"\"key,with \"\"special\"\" characters\"," +
+		"0x0300000000000000000000000000000000000000," +
+		"2a5d5f472e376dc28964e1b597b1ca5ee5ac042101b5199a3ca8dae2deec3538," +
+		"2,1.00000000,0,false,200\n"
+
+	testutils.AssertStringsEqual(
+		t,
+		"CSV output",
+		expectedOutput,
+		buffer.String(),
+	)
+
+	// Make sure the output can be read back and escaped fields are preserved.
+	records, err := csv.NewReader(&buffer).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutils.AssertIntsEqual(t, "records count", 3, len(records))
+	testutils.AssertStringsEqual(
+		t,
+		"escaped deposit ID",
+		deposits[1].DepositKey,
+		records[2][0],
+	)
+}
+
+func TestExportDepositsCSV_HideSwept(t *testing.T) {
+	walletPublicKeyHash := [20]byte{0x01}
+
+	tbtcChain := tbtcpg.NewLocalChain()
+	btcChain := tbtcpg.NewLocalBitcoinChain()
+
+	for i, sweptAt := range []time.Time{time.Unix(0, 0), time.Now()} {
+		fundingTxHash := bitcoin.Hash{byte(i + 1)}
+
+		tbtcChain.SetDepositRequest(
+			fundingTxHash,
+			0,
+			&tbtc.DepositChainRequest{
+				Amount:     100000,
+				RevealedAt: time.Now().Add(-time.Hour),
+				SweptAt:    sweptAt,
+			},
+		)
+		btcChain.SetTransactionConfirmations(fundingTxHash, 6)
+
+		err := tbtcChain.AddPastDepositRevealedEvent(
+			&tbtc.DepositRevealedEventFilter{
+				WalletPublicKeyHash: [][20]byte{walletPublicKeyHash},
+			},
+			&tbtc.DepositRevealedEvent{
+				BlockNumber:         uint64(i + 1),
+				WalletPublicKeyHash: walletPublicKeyHash,
+				FundingTxHash:       fundingTxHash,
+				FundingOutputIndex:  0,
+			},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var tests = map[string]struct {
+		hideSwept           bool
+		expectedDepositKeys []string
+	}{
+		"swept deposits included": {
+			hideSwept: false,
+			expectedDepositKeys: []string{
+				hexutils.Encode(
+					tbtcChain.BuildDepositKey(bitcoin.Hash{1}, 0).Bytes(),
+				),
+				hexutils.Encode(
+					tbtcChain.BuildDepositKey(bitcoin.Hash{2}, 0).Bytes(),
+				),
+			},
+		},
+		"swept deposits excluded": {
+			hideSwept: true,
+			expectedDepositKeys: []string{
+				hexutils.Encode(
+					tbtcChain.BuildDepositKey(bitcoin.Hash{1}, 0).Bytes(),
+				),
+			},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			deposits, err := tbtcpg.FindDeposits(
+				tbtcChain,
+				btcChain,
+				walletPublicKeyHash,
+				0,
+				test.hideSwept,
+				false,
+				0,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buffer bytes.Buffer
+			if err := tbtcpg.ExportDepositsCSV(deposits, &buffer); err != nil {
+				t.Fatal(err)
+			}
+
+			records, err := csv.NewReader(&buffer).ReadAll()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Skip the header row.
+			actualDepositKeys := make([]string, 0)
+			for _, record := range records[1:] {
+				actualDepositKeys = append(actualDepositKeys, record[0])
+			}
+
+			if !reflect.DeepEqual(test.expectedDepositKeys, actualDepositKeys) {
+				t.Errorf(
+					"unexpected deposits\nexpected: %v\nactual:   %v",
+					test.expectedDepositKeys,
+					actualDepositKeys,
+				)
+			}
+		})
+	}
+}
+
+func TestGroupDepositsByWallet(t *testing.T) {
+	wallet1 := [20]byte{0x01}
+	wallet2 := [20]byte{0x02}
+	wallet3 := [20]byte{0x03}
+
+	newDeposit := func(
+		walletPublicKeyHash [20]byte,
+		revealBlock uint64,
+		amountBtc float64,
+		isSwept bool,
+	) *tbtcpg.Deposit {
+		return &tbtcpg.Deposit{
+			DepositReference: tbtcpg.DepositReference{
+				FundingTxHash: bitcoin.Hash{byte(revealBlock)},
+				RevealBlock:   revealBlock,
+			},
+			WalletPublicKeyHash: walletPublicKeyHash,
+			IsSwept:             isSwept,
+			AmountBtc:           amountBtc,
+		}
+	}
+
+	wallet1Swept := newDeposit(wallet1, 1, 0.5, true)
+	wallet1Unswept := newDeposit(wallet1, 5, 0.25, false)
+	wallet2Oldest := newDeposit(wallet2, 3, 0.5, false)
+	wallet2Newest := newDeposit(wallet2, 4, 0.25, false)
+	wallet3Swept := newDeposit(wallet3, 2, 1, true)
+
+	// Deposits are not ordered by their reveal blocks to make sure the
+	// oldest unswept deposit does not depend on the input order.
+	summaries := tbtcpg.GroupDepositsByWallet([]*tbtcpg.Deposit{
+		wallet1Swept,
+		wallet2Newest,
+		wallet3Swept,
+		wallet1Unswept,
+		wallet2Oldest,
+	})
+
+	expectedSummaries := []*tbtcpg.WalletDepositSummary{
+		{
+			WalletPublicKeyHash:  wallet2,
+			TotalAmountBtc:       0.75,
+			UnsweptAmountBtc:     0.75,
+			DepositsCount:        2,
+			SweptCount:           0,
+			OldestUnsweptDeposit: wallet2Oldest,
+		},
+		{
+			WalletPublicKeyHash:  wallet1,
+			TotalAmountBtc:       0.75,
+			UnsweptAmountBtc:     0.25,
+			DepositsCount:        2,
+			SweptCount:           1,
+			OldestUnsweptDeposit: wallet1Unswept,
+		},
+		{
+			WalletPublicKeyHash:  wallet3,
+			TotalAmountBtc:       1,
+			UnsweptAmountBtc:     0,
+			DepositsCount:        1,
+			SweptCount:           1,
+			OldestUnsweptDeposit: nil,
+		},
+	}
+
+	if diff := deep.Equal(expectedSummaries, summaries); diff != nil {
+		t.Errorf("invalid summaries: %v", diff)
+	}
+}
+
+func TestFindDeposits_MinConfirmations(t *testing.T) {
+	walletPublicKeyHash := [20]byte{0x01}
+
+	tbtcChain := tbtcpg.NewLocalChain()
+	btcChain := tbtcpg.NewLocalBitcoinChain()
+
+	for i, confirmations := range []uint{2, 6} {
+		fundingTxHash := bitcoin.Hash{byte(i + 1)}
+
+		tbtcChain.SetDepositRequest(
+			fundingTxHash,
+			0,
+			&tbtc.DepositChainRequest{
+				Amount:     100000,
+				RevealedAt: time.Now().Add(-time.Hour),
+				SweptAt:    time.Unix(0, 0),
+			},
+		)
+		btcChain.SetTransactionConfirmations(fundingTxHash, confirmations)
+
+		err := tbtcChain.AddPastDepositRevealedEvent(
+			&tbtc.DepositRevealedEventFilter{
+				WalletPublicKeyHash: [][20]byte{walletPublicKeyHash},
+			},
+			&tbtc.DepositRevealedEvent{
+				BlockNumber:         uint64(i + 1),
+				WalletPublicKeyHash: walletPublicKeyHash,
+				FundingTxHash:       fundingTxHash,
+				FundingOutputIndex:  0,
+			},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	depositKey := func(fundingTxHash bitcoin.Hash) string {
+		return hexutils.Encode(
+			tbtcChain.BuildDepositKey(fundingTxHash, 0).Bytes(),
+		)
+	}
+
+	var tests = map[string]struct {
+		minConfirmations    uint
+		expectedDepositKeys []string
+	}{
+		"no confirmations filter": {
+			minConfirmations: 0,
+			expectedDepositKeys: []string{
+				depositKey(bitcoin.Hash{1}),
+				depositKey(bitcoin.Hash{2}),
+			},
+		},
+		"filter excluding some deposits": {
+			minConfirmations: 3,
+			expectedDepositKeys: []string{
+				depositKey(bitcoin.Hash{2}),
+			},
+		},
+		"filter equal to the confirmations count": {
+			minConfirmations: 6,
+			expectedDepositKeys: []string{
+				depositKey(bitcoin.Hash{2}),
+			},
+		},
+		"filter excluding all deposits": {
+			minConfirmations:    7,
+			expectedDepositKeys: []string{},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			deposits, err := tbtcpg.FindDeposits(
+				tbtcChain,
+				btcChain,
+				walletPublicKeyHash,
+				0,
+				false,
+				false,
+				test.minConfirmations,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actualDepositKeys := make([]string, 0)
+			for _, deposit := range deposits {
+				actualDepositKeys = append(
+					actualDepositKeys,
+					deposit.DepositKey,
+				)
+			}
+
+			if !reflect.DeepEqual(test.expectedDepositKeys, actualDepositKeys) {
+				t.Errorf(
+					"unexpected deposits\nexpected: %v\nactual:   %v",
+					test.expectedDepositKeys,
+					actualDepositKeys,
+				)
+			}
+		})
+	}
+}
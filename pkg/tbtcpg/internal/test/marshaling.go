@@ -211,6 +211,7 @@ func (psts *ProposeSweepTestScenario) UnmarshalJSON(data []byte) error {
 			FundingTxConfirmations uint
 		}
 		SweepTxFee                   int64
+		MaxSweepTxFee                int64
 		EstimateSatPerVByteFee       int64
 		ExpectedDepositSweepProposal *depositSweepProposal
 		ExpectedErr                  string
@@ -259,6 +260,9 @@ func (psts *ProposeSweepTestScenario) UnmarshalJSON(data []byte) error {
 	// Unmarshal sweep transaction fee.
 	psts.SweepTxFee = unmarshaled.SweepTxFee
 
+	// Unmarshal maximum sweep transaction fee.
+	psts.MaxSweepTxFee = unmarshaled.MaxSweepTxFee
+
 	// Unmarshal estimate sat per vbyte fee.
 	psts.EstimateSatPerVByteFee = unmarshaled.EstimateSatPerVByteFee
 
@@ -77,6 +77,7 @@ type ProposeSweepTestScenario struct {
 	DepositTxMaxFee              uint64
 	Deposits                     []*ProposeSweepDepositsData
 	SweepTxFee                   int64
+	MaxSweepTxFee                int64
 	EstimateSatPerVByteFee       int64
 	ExpectedDepositSweepProposal *tbtc.DepositSweepProposal
 	ExpectedErr                  error
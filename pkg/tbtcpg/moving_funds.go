@@ -121,6 +121,7 @@ func (mft *MovingFundsTask) Run(request *tbtc.CoordinationProposalRequest) (
 		1,
 		true,
 		true,
+		0,
 	)
 	if err != nil {
 		return nil, false, fmt.Errorf(
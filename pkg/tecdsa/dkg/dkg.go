@@ -15,9 +15,34 @@ import (
 	"github.com/keep-network/keep-core/pkg/protocol/state"
 )
 
+// ExecutionPhases are the names of the phases of the tECDSA distributed key
+// generation protocol, in the order of their execution.
+var ExecutionPhases = []string{
+	"ephemeral_key_pair_generation",
+	"symmetric_key_generation",
+	"tss_round_one",
+	"tss_round_two",
+	"tss_round_three",
+	"finalization",
+}
+
+// PhaseDurationBuckets are the upper bounds of the buckets of the histograms
+// recording the duration of the distributed key generation protocol phases.
+var PhaseDurationBuckets = []time.Duration{
+	1 * time.Second,
+	5 * time.Second,
+	15 * time.Second,
+	30 * time.Second,
+	1 * time.Minute,
+	2 * time.Minute,
+	5 * time.Minute,
+}
+
 // Executor represents an ECDSA distributed key generation process executor.
 type Executor struct {
 	tssPreParamsPool         *tssPreParamsPool
+	preParamsAge             *durationHistogram
+	phaseDurations           map[string]*durationHistogram
 	keyGenerationConcurrency int
 }
 
@@ -36,6 +61,12 @@ func NewExecutor(
 		"ECDSA key generation concurrency level is [%d]",
 		keyGenerationConcurrency,
 	)
+
+	phaseDurations := make(map[string]*durationHistogram, len(ExecutionPhases))
+	for _, phase := range ExecutionPhases {
+		phaseDurations[phase] = newDurationHistogram(PhaseDurationBuckets)
+	}
+
 	return &Executor{
 		tssPreParamsPool: newTssPreParamsPool(
 			logger,
@@ -46,6 +77,8 @@ func NewExecutor(
 			preParamsGenerationDelay,
 			preParamsGenerationConcurrency,
 		),
+		preParamsAge:             newDurationHistogram(PreParamsAgeBuckets),
+		phaseDurations:           phaseDurations,
 		keyGenerationConcurrency: keyGenerationConcurrency,
 	}
 }
@@ -80,7 +113,7 @@ func (e *Executor) Execute(
 		dishonestThreshold,
 		membershipValidator,
 		sessionID,
-		e.tssPreParamsPool.GetNow,
+		e.getPreParams,
 		e.keyGenerationConcurrency,
 	)
 
@@ -98,7 +131,13 @@ func (e *Executor) Execute(
 		member:         member.initializeEphemeralKeysGeneration(),
 	}
 
-	stateMachine := state.NewAsyncMachine(logger, ctx, channel, initialState)
+	stateMachine := state.NewAsyncMachine(
+		logger,
+		ctx,
+		channel,
+		initialState,
+		state.WithStateDurationObserver(e.observePhaseDuration),
+	)
 
 	lastState, err := stateMachine.Execute()
 	if err != nil {
@@ -113,11 +152,88 @@ func (e *Executor) Execute(
 	return finalizationState.result(), nil
 }
 
+// getPreParams takes DKG pre-parameters from the pool and records their age.
+func (e *Executor) getPreParams() (*PreParams, error) {
+	preParams, err := e.tssPreParamsPool.GetNow()
+	if err != nil {
+		return nil, err
+	}
+
+	e.preParamsAge.observe(time.Since(preParams.creationTimestamp))
+
+	return preParams, nil
+}
+
+// PreParamsAgeHistogram returns the histogram of the age of DKG
+// pre-parameters at the time they were consumed by DKG protocol executions.
+// Drained pre-parameters are not recorded. The histogram's buckets are
+// determined by PreParamsAgeBuckets.
+func (e *Executor) PreParamsAgeHistogram() *DurationHistogram {
+	return e.preParamsAge.snapshot()
+}
+
+// observePhaseDuration records the duration of the DKG protocol phase
+// represented by the given state. States not being part of the DKG protocol
+// execution are ignored.
+func (e *Executor) observePhaseDuration(
+	currentState state.AsyncState,
+	duration time.Duration,
+) {
+	phase, ok := executionPhase(currentState)
+	if !ok {
+		return
+	}
+
+	e.phaseDurations[phase].observe(duration)
+}
+
+// PhaseDurationHistograms returns histograms of the duration of DKG protocol
+// phases, by phase name. Phase names are determined by ExecutionPhases and
+// the histograms' buckets by PhaseDurationBuckets.
+func (e *Executor) PhaseDurationHistograms() map[string]*DurationHistogram {
+	histograms := make(map[string]*DurationHistogram, len(e.phaseDurations))
+	for phase, histogram := range e.phaseDurations {
+		histograms[phase] = histogram.snapshot()
+	}
+
+	return histograms
+}
+
+// executionPhase returns the name of the DKG protocol phase represented by
+// the given state. The second return value is false if the state is not
+// a part of the DKG protocol execution.
+func executionPhase(currentState state.AsyncState) (string, bool) {
+	switch currentState.(type) {
+	case *ephemeralKeyPairGenerationState:
+		return ExecutionPhases[0], true
+	case *symmetricKeyGenerationState:
+		return ExecutionPhases[1], true
+	case *tssRoundOneState:
+		return ExecutionPhases[2], true
+	case *tssRoundTwoState:
+		return ExecutionPhases[3], true
+	case *tssRoundThreeState:
+		return ExecutionPhases[4], true
+	case *finalizationState:
+		return ExecutionPhases[5], true
+	default:
+		return "", false
+	}
+}
+
 // PreParamsCount returns the current count of the DKG pre-parameters.
 func (e *Executor) PreParamsCount() int {
 	return e.tssPreParamsPool.ParametersCount()
 }
 
+// DrainPreParams removes up to n DKG pre-parameters from the pool and returns
+// them without using them for a DKG execution. It can be used to invalidate
+// pre-parameters that must not be used by the protocol anymore. The pool
+// generates new pre-parameters in place of the drained ones.
+func (e *Executor) DrainPreParams(n int) ([]*PreParams, error) {
+	return e.tssPreParamsPool.Drain(n)
+}
+
 // SignedResult represents information pertaining to the process of signing
 // a DKG result: the public key used during signing, the resulting signature and
 // the hash of the DKG result that was used during signing.
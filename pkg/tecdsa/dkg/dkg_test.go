@@ -0,0 +1,383 @@
+package dkg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	fuzz "github.com/google/gofuzz"
+
+	"github.com/keep-network/keep-core/internal/testutils"
+	"github.com/keep-network/keep-core/pkg/chain"
+	"github.com/keep-network/keep-core/pkg/chain/local_v1"
+	"github.com/keep-network/keep-core/pkg/crypto/ephemeral"
+	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-core/pkg/net/local"
+	"github.com/keep-network/keep-core/pkg/operator"
+	"github.com/keep-network/keep-core/pkg/protocol/group"
+	"github.com/keep-network/keep-core/pkg/protocol/state"
+)
+
+func TestExecutor_ObservePhaseDuration(t *testing.T) {
+	var tests = map[string]struct {
+		state         state.AsyncState
+		expectedPhase string
+	}{
+		"ephemeral key pair generation": {
+			state:         &ephemeralKeyPairGenerationState{},
+			expectedPhase: "ephemeral_key_pair_generation",
+		},
+		"symmetric key generation": {
+			state:         &symmetricKeyGenerationState{},
+			expectedPhase: "symmetric_key_generation",
+		},
+		"tss round one": {
+			state:         &tssRoundOneState{},
+			expectedPhase: "tss_round_one",
+		},
+		"tss round two": {
+			state:         &tssRoundTwoState{},
+			expectedPhase: "tss_round_two",
+		},
+		"tss round three": {
+			state:         &tssRoundThreeState{},
+			expectedPhase: "tss_round_three",
+		},
+		"finalization": {
+			state:         &finalizationState{},
+			expectedPhase: "finalization",
+		},
+		"result signing": {
+			state:         &resultSigningState{},
+			expectedPhase: "",
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			phaseDurations := make(map[string]*durationHistogram)
+			for _, phase := range ExecutionPhases {
+				phaseDurations[phase] = newDurationHistogram(PhaseDurationBuckets)
+			}
+
+			executor := &Executor{phaseDurations: phaseDurations}
+
+			executor.observePhaseDuration(test.state, 10*time.Second)
+
+			histograms := executor.PhaseDurationHistograms()
+
+			testutils.AssertIntsEqual(
+				t,
+				"phases count",
+				len(ExecutionPhases),
+				len(histograms),
+			)
+
+			for phase, histogram := range histograms {
+				expectedCount := uint64(0)
+				expectedSum := time.Duration(0)
+				if phase == test.expectedPhase {
+					expectedCount = 1
+					expectedSum = 10 * time.Second
+				}
+
+				testutils.AssertUintsEqual(
+					t,
+					phase+" count",
+					expectedCount,
+					histogram.Count,
+				)
+
+				if expectedSum != histogram.Sum {
+					t.Errorf(
+						"unexpected %s sum\n"+
+							"expected: [%v]\n"+
+							"actual:   [%v]",
+						phase,
+						expectedSum,
+						histogram.Sum,
+					)
+				}
+			}
+		})
+	}
+}
+
+// TestExecutor_Execute_ContextCancelledNoGoroutineLeak makes sure that no
+// goroutines started by the executor outlive the execution when the execution
+// context gets cancelled.
+func TestExecutor_Execute_ContextCancelledNoGoroutineLeak(t *testing.T) {
+	groupSize := 3
+	dishonestThreshold := 1
+
+	localChain := local_v1.Connect(groupSize, groupSize-dishonestThreshold)
+
+	operatorsAddresses := make([]chain.Address, groupSize)
+	for i := range operatorsAddresses {
+		_, operatorPublicKey, err := operator.GenerateKeyPair(
+			local_v1.DefaultCurve,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		operatorAddress, err := localChain.Signing().PublicKeyToAddress(
+			operatorPublicKey,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		operatorsAddresses[i] = operatorAddress
+	}
+
+	membershipValidator := group.NewMembershipValidator(
+		&testutils.MockLogger{},
+		operatorsAddresses,
+		localChain.Signing(),
+	)
+
+	channel, err := local.Connect().BroadcastChannelFor(
+		"tecdsa-dkg-execute-context-cancelled",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	RegisterUnmarshallers(channel)
+
+	var tests = map[string]struct {
+		// cancelDelay is the delay of the context cancellation counted from
+		// the moment the execution starts. A negative delay means the context
+		// is cancelled before the execution starts.
+		cancelDelay time.Duration
+	}{
+		"context cancelled before the execution": {
+			cancelDelay: -1,
+		},
+		"context cancelled immediately after the execution starts": {
+			cancelDelay: 0,
+		},
+		"context cancelled while waiting for other members": {
+			cancelDelay: 100 * time.Millisecond,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			goroutinesBefore := runtime.NumGoroutine()
+
+			phaseDurations := make(map[string]*durationHistogram)
+			for _, phase := range ExecutionPhases {
+				phaseDurations[phase] = newDurationHistogram(PhaseDurationBuckets)
+			}
+
+			// Other members never participate so, the execution never
+			// reaches the point where pre-parameters are needed.
+			executor := &Executor{
+				preParamsAge:             newDurationHistogram(PreParamsAgeBuckets),
+				phaseDurations:           phaseDurations,
+				keyGenerationConcurrency: 1,
+			}
+
+			ctx, cancelCtx := context.WithCancel(context.Background())
+			if test.cancelDelay < 0 {
+				cancelCtx()
+			} else {
+				go func() {
+					time.Sleep(test.cancelDelay)
+					cancelCtx()
+				}()
+			}
+
+			result, err := executor.Execute(
+				ctx,
+				&testutils.MockLogger{},
+				big.NewInt(100),
+				"session-1",
+				group.MemberIndex(1),
+				groupSize,
+				dishonestThreshold,
+				[]group.MemberIndex{},
+				channel,
+				membershipValidator,
+			)
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf(
+					"unexpected error\n"+
+						"expected: [%v]\n"+
+						"actual:   [%v]",
+					context.Canceled,
+					err,
+				)
+			}
+			if result != nil {
+				t.Errorf("unexpected result: [%v]", result)
+			}
+
+			// Give the goroutines some time to exit.
+			deadline := time.Now().Add(1 * time.Second)
+			for runtime.NumGoroutine() > goroutinesBefore &&
+				time.Now().Before(deadline) {
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			if goroutinesAfter := runtime.NumGoroutine(); goroutinesAfter > goroutinesBefore {
+				t.Errorf(
+					"goroutines leaked\n"+
+						"before: [%v]\n"+
+						"after:  [%v]",
+					goroutinesBefore,
+					goroutinesAfter,
+				)
+			}
+		})
+	}
+}
+
+func TestRegisterUnmarshallers(t *testing.T) {
+	for i := 0; i < 5; i++ {
+		t.Run(fmt.Sprintf("round %v", i), func(t *testing.T) {
+			messages := fuzzMessages(t)
+
+			ctx, cancelCtx := context.WithTimeout(
+				context.Background(),
+				10*time.Second,
+			)
+			defer cancelCtx()
+
+			// Use a separate channel for each round so retransmissions from
+			// previous rounds are not received.
+			channel, err := local.Connect().BroadcastChannelFor(
+				fmt.Sprintf("tecdsa-dkg-register-unmarshallers-%v", i),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			RegisterUnmarshallers(channel)
+
+			receivedMessages := make(chan net.Message, 2*len(messages))
+			channel.Recv(ctx, func(netMessage net.Message) {
+				receivedMessages <- netMessage
+			})
+
+			for _, msg := range messages {
+				err := channel.Send(ctx, msg)
+				if err != nil {
+					t.Fatalf(
+						"cannot send message [%v] through the channel: [%v]",
+						msg.Type(),
+						err,
+					)
+				}
+			}
+
+			payloadsByType := make(map[string]interface{})
+			for len(payloadsByType) < len(messages) {
+				select {
+				case netMessage := <-receivedMessages:
+					// Retransmissions of already received messages are ignored.
+					if _, ok := payloadsByType[netMessage.Type()]; !ok {
+						payloadsByType[netMessage.Type()] = netMessage.Payload()
+					}
+				case <-ctx.Done():
+					t.Fatalf(
+						"received [%v] out of [%v] message types",
+						len(payloadsByType),
+						len(messages),
+					)
+				}
+			}
+
+			for _, msg := range messages {
+				payload := payloadsByType[msg.Type()]
+
+				if !reflect.DeepEqual(msg, payload) {
+					t.Errorf(
+						"unexpected content of message [%v]\n"+
+							"expected: [%+v]\n"+
+							"actual:   [%+v]",
+						msg.Type(),
+						msg,
+						payload,
+					)
+				}
+			}
+		})
+	}
+}
+
+// fuzzMessages returns one randomly generated message of each type
+// registered by RegisterUnmarshallers. Collections and strings are never
+// empty as empty and nil values are not distinguished by protobuf.
+func fuzzMessages(t *testing.T) []net.TaggedMarshaler {
+	f := fuzz.New().NilChance(0).NumElements(1, 32)
+
+	keyPair, err := ephemeral.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		senderID         group.MemberIndex
+		receiverID       group.MemberIndex
+		broadcastPayload []byte
+		peersPayload     map[group.MemberIndex][]byte
+		resultHash       ResultSignatureHash
+		signature        []byte
+		publicKey        []byte
+		sessionID        string
+	)
+
+	f.Fuzz(&senderID)
+	f.Fuzz(&receiverID)
+	f.Fuzz(&broadcastPayload)
+	f.Fuzz(&peersPayload)
+	f.Fuzz(&resultHash)
+	f.Fuzz(&signature)
+	f.Fuzz(&publicKey)
+	f.Fuzz(&sessionID)
+
+	return []net.TaggedMarshaler{
+		&ephemeralPublicKeyMessage{
+			senderID: senderID,
+			ephemeralPublicKeys: map[group.MemberIndex]*ephemeral.PublicKey{
+				receiverID: keyPair.PublicKey,
+			},
+			sessionID: sessionID,
+		},
+		&tssRoundOneMessage{
+			senderID:         senderID,
+			broadcastPayload: broadcastPayload,
+			sessionID:        sessionID,
+		},
+		&tssRoundTwoMessage{
+			senderID:         senderID,
+			broadcastPayload: broadcastPayload,
+			peersPayload:     peersPayload,
+			sessionID:        sessionID,
+		},
+		&tssRoundThreeMessage{
+			senderID:         senderID,
+			broadcastPayload: broadcastPayload,
+			sessionID:        sessionID,
+		},
+		&tssFinalizationMessage{
+			senderID:  senderID,
+			sessionID: sessionID,
+		},
+		&resultSignatureMessage{
+			senderID:   senderID,
+			resultHash: resultHash,
+			signature:  signature,
+			publicKey:  publicKey,
+			sessionID:  sessionID,
+		},
+	}
+}
@@ -0,0 +1,66 @@
+package dkg
+
+import (
+	"sync"
+	"time"
+)
+
+// DurationHistogram is a snapshot of a histogram of observed durations.
+type DurationHistogram struct {
+	// BucketCounts holds the number of observed durations less than or equal
+	// to the upper bound of the bucket at the same index of the buckets the
+	// histogram was created with. Bucket counts are cumulative.
+	BucketCounts []uint64
+	// Count is the total number of observed durations, including the ones
+	// greater than the upper bound of the last bucket.
+	Count uint64
+	// Sum is the total of all observed durations.
+	Sum time.Duration
+}
+
+// durationHistogram records observed durations in buckets with the given
+// upper bounds. It is safe for concurrent use.
+type durationHistogram struct {
+	mutex        sync.Mutex
+	buckets      []time.Duration
+	bucketCounts []uint64
+	count        uint64
+	sum          time.Duration
+}
+
+func newDurationHistogram(buckets []time.Duration) *durationHistogram {
+	return &durationHistogram{
+		buckets:      buckets,
+		bucketCounts: make([]uint64, len(buckets)),
+	}
+}
+
+// observe records the given duration.
+func (dh *durationHistogram) observe(duration time.Duration) {
+	dh.mutex.Lock()
+	defer dh.mutex.Unlock()
+
+	for i, upperBound := range dh.buckets {
+		if duration <= upperBound {
+			dh.bucketCounts[i]++
+		}
+	}
+
+	dh.count++
+	dh.sum += duration
+}
+
+// snapshot returns the current state of the histogram.
+func (dh *durationHistogram) snapshot() *DurationHistogram {
+	dh.mutex.Lock()
+	defer dh.mutex.Unlock()
+
+	bucketCounts := make([]uint64, len(dh.bucketCounts))
+	copy(bucketCounts, dh.bucketCounts)
+
+	return &DurationHistogram{
+		BucketCounts: bucketCounts,
+		Count:        dh.count,
+		Sum:          dh.sum,
+	}
+}
@@ -0,0 +1,88 @@
+package dkg
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/keep-network/keep-core/internal/testutils"
+)
+
+func TestDurationHistogram(t *testing.T) {
+	var tests = map[string]struct {
+		durations            []time.Duration
+		expectedBucketCounts []uint64
+	}{
+		"no durations observed": {
+			durations:            []time.Duration{},
+			expectedBucketCounts: []uint64{0, 0, 0, 0, 0},
+		},
+		"duration lower than the first bucket": {
+			durations:            []time.Duration{30 * time.Minute},
+			expectedBucketCounts: []uint64{1, 1, 1, 1, 1},
+		},
+		"duration in the middle bucket": {
+			durations:            []time.Duration{7 * time.Hour},
+			expectedBucketCounts: []uint64{0, 0, 1, 1, 1},
+		},
+		"duration greater than the last bucket": {
+			durations:            []time.Duration{72 * time.Hour},
+			expectedBucketCounts: []uint64{0, 0, 0, 0, 0},
+		},
+		"various durations": {
+			durations: []time.Duration{
+				30 * time.Minute,
+				5 * time.Hour,
+				7 * time.Hour,
+				20 * time.Hour,
+				30 * time.Hour,
+				72 * time.Hour,
+			},
+			expectedBucketCounts: []uint64{1, 2, 3, 4, 5},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			histogram := newDurationHistogram(PreParamsAgeBuckets)
+
+			expectedSum := time.Duration(0)
+			for _, duration := range test.durations {
+				histogram.observe(duration)
+				expectedSum += duration
+			}
+
+			snapshot := histogram.snapshot()
+
+			if !reflect.DeepEqual(
+				test.expectedBucketCounts,
+				snapshot.BucketCounts,
+			) {
+				t.Errorf(
+					"unexpected bucket counts\n"+
+						"expected: [%v]\n"+
+						"actual:   [%v]",
+					test.expectedBucketCounts,
+					snapshot.BucketCounts,
+				)
+			}
+
+			testutils.AssertUintsEqual(
+				t,
+				"count",
+				uint64(len(test.durations)),
+				snapshot.Count,
+			)
+
+			if expectedSum != snapshot.Sum {
+				t.Errorf(
+					"unexpected sum\n"+
+						"expected: [%v]\n"+
+						"actual:   [%v]",
+					expectedSum,
+					snapshot.Sum,
+				)
+			}
+		})
+	}
+}
@@ -103,6 +103,16 @@ func newTssPreParamsPool(
 	}
 }
 
+// PreParamsAgeBuckets are the upper bounds of the buckets of the histogram
+// recording the age of pre-parameters at the time they are consumed.
+var PreParamsAgeBuckets = []time.Duration{
+	1 * time.Hour,
+	6 * time.Hour,
+	12 * time.Hour,
+	24 * time.Hour,
+	48 * time.Hour,
+}
+
 const (
 	dirName = "preparams"
 )
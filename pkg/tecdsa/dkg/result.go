@@ -73,6 +73,15 @@ func (r *Result) MisbehavedMembersIndexes() []group.MemberIndex {
 	return sorted
 }
 
+// IsComplete returns true if all group members are still operating at
+// the end of the DKG procedure, i.e. no member was marked as inactive or
+// disqualified. A result with misbehaved members is a partial one; it is
+// still valid as long as at least the honest threshold of members are
+// operating but the produced signing group is smaller than the selected one.
+func (r *Result) IsComplete() bool {
+	return len(r.Group.OperatingMemberIndexes()) == r.Group.GroupSize()
+}
+
 const ResultSignatureHashByteSize = 32
 
 // ResultSignatureHash is a signature hash of the DKG Result. The hashing
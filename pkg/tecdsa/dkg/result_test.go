@@ -0,0 +1,61 @@
+package dkg
+
+import (
+	"testing"
+
+	"github.com/keep-network/keep-core/pkg/protocol/group"
+)
+
+func TestResult_IsComplete(t *testing.T) {
+	// Group of 5 members with the honest threshold of 3.
+	groupSize := 5
+	dishonestThreshold := 2
+
+	var tests = map[string]struct {
+		misbehavedMembers []group.MemberIndex
+		expectedComplete  bool
+	}{
+		"all members operating": {
+			misbehavedMembers: []group.MemberIndex{},
+			expectedComplete:  true,
+		},
+		"more than honest threshold of members operating": {
+			misbehavedMembers: []group.MemberIndex{2},
+			expectedComplete:  false,
+		},
+		"exactly honest threshold of members operating": {
+			misbehavedMembers: []group.MemberIndex{2, 5},
+			expectedComplete:  false,
+		},
+		"fewer than honest threshold of members operating": {
+			misbehavedMembers: []group.MemberIndex{1, 2, 5},
+			expectedComplete:  false,
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			dkgGroup := group.NewGroup(dishonestThreshold, groupSize)
+			for i, memberIndex := range test.misbehavedMembers {
+				// Use both ways of excluding members.
+				if i%2 == 0 {
+					dkgGroup.MarkMemberAsInactive(memberIndex)
+				} else {
+					dkgGroup.MarkMemberAsDisqualified(memberIndex)
+				}
+			}
+
+			result := &Result{Group: dkgGroup}
+
+			if test.expectedComplete != result.IsComplete() {
+				t.Errorf(
+					"unexpected result completeness\n"+
+						"expected: [%v]\n"+
+						"actual:   [%v]",
+					test.expectedComplete,
+					result.IsComplete(),
+				)
+			}
+		})
+	}
+}
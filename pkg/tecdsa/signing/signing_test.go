@@ -0,0 +1,241 @@
+package signing
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/keep-network/keep-core/pkg/crypto/ephemeral"
+	"github.com/keep-network/keep-core/pkg/net"
+	"github.com/keep-network/keep-core/pkg/net/local"
+	"github.com/keep-network/keep-core/pkg/protocol/group"
+	"github.com/keep-network/keep-core/pkg/tecdsa/signing/gen/pb"
+)
+
+func TestRegisterUnmarshallers(t *testing.T) {
+	keyPair, err := ephemeral.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Use the boundary member index values to make sure none of them is
+	// truncated on the way.
+	minMemberIndex := group.MemberIndex(1)
+	maxMemberIndex := group.MemberIndex(group.MaxMemberIndex)
+
+	peersPayload := map[group.MemberIndex][]byte{
+		minMemberIndex: {1, 2, 3},
+		maxMemberIndex: {4, 5, 6},
+	}
+	broadcastPayload := []byte{7, 8, 9, 10}
+	sessionID := "session-1"
+
+	messages := []message{
+		&ephemeralPublicKeyMessage{
+			senderID: maxMemberIndex,
+			ephemeralPublicKeys: map[group.MemberIndex]*ephemeral.PublicKey{
+				minMemberIndex: keyPair.PublicKey,
+			},
+			sessionID: sessionID,
+		},
+		&tssRoundOneMessage{
+			senderID:         maxMemberIndex,
+			broadcastPayload: broadcastPayload,
+			peersPayload:     peersPayload,
+			sessionID:        sessionID,
+		},
+		&tssRoundTwoMessage{
+			senderID:     maxMemberIndex,
+			peersPayload: peersPayload,
+			sessionID:    sessionID,
+		},
+		&tssRoundThreeMessage{
+			senderID:         maxMemberIndex,
+			broadcastPayload: broadcastPayload,
+			sessionID:        sessionID,
+		},
+		&tssRoundFourMessage{
+			senderID:         maxMemberIndex,
+			broadcastPayload: broadcastPayload,
+			sessionID:        sessionID,
+		},
+		&tssRoundFiveMessage{
+			senderID:         maxMemberIndex,
+			broadcastPayload: broadcastPayload,
+			sessionID:        sessionID,
+		},
+		&tssRoundSixMessage{
+			senderID:         maxMemberIndex,
+			broadcastPayload: broadcastPayload,
+			sessionID:        sessionID,
+		},
+		&tssRoundSevenMessage{
+			senderID:         maxMemberIndex,
+			broadcastPayload: broadcastPayload,
+			sessionID:        sessionID,
+		},
+		&tssRoundEightMessage{
+			senderID:         maxMemberIndex,
+			broadcastPayload: broadcastPayload,
+			sessionID:        sessionID,
+		},
+		&tssRoundNineMessage{
+			senderID:         maxMemberIndex,
+			broadcastPayload: broadcastPayload,
+			sessionID:        sessionID,
+		},
+	}
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelCtx()
+
+	channel, err := local.Connect().BroadcastChannelFor(
+		"tecdsa-signing-register-unmarshallers",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	RegisterUnmarshallers(channel)
+
+	receivedMessages := make(chan net.Message, 2*len(messages))
+	channel.Recv(ctx, func(netMessage net.Message) {
+		receivedMessages <- netMessage
+	})
+
+	for _, msg := range messages {
+		taggedMarshaler, ok := msg.(net.TaggedMarshaler)
+		if !ok {
+			t.Fatalf("message [%v] is not a tagged marshaler", msg.Type())
+		}
+
+		err := channel.Send(ctx, taggedMarshaler)
+		if err != nil {
+			t.Fatalf(
+				"cannot send message [%v] through the channel: [%v]",
+				msg.Type(),
+				err,
+			)
+		}
+	}
+
+	payloadsByType := make(map[string]interface{})
+	for len(payloadsByType) < len(messages) {
+		select {
+		case netMessage := <-receivedMessages:
+			// Retransmissions of already received messages are ignored.
+			if _, ok := payloadsByType[netMessage.Type()]; !ok {
+				payloadsByType[netMessage.Type()] = netMessage.Payload()
+			}
+		case <-ctx.Done():
+			t.Fatalf(
+				"received [%v] out of [%v] message types",
+				len(payloadsByType),
+				len(messages),
+			)
+		}
+	}
+
+	for _, msg := range messages {
+		payload, ok := payloadsByType[msg.Type()]
+		if !ok {
+			t.Errorf("message [%v] has not been received", msg.Type())
+			continue
+		}
+
+		if !reflect.DeepEqual(msg, payload) {
+			t.Errorf(
+				"unexpected content of message [%v]\n"+
+					"expected: [%+v]\n"+
+					"actual:   [%+v]",
+				msg.Type(),
+				msg,
+				payload,
+			)
+		}
+	}
+}
+
+func TestRegisterUnmarshallers_MemberIndexOverflow(t *testing.T) {
+	// Protobuf messages carry member indexes as uint32 while group members
+	// are indexed with uint8. Values not fitting into uint8 must be rejected
+	// instead of being silently truncated.
+	overflowingIndex := uint32(group.MaxMemberIndex) + 1
+
+	var tests = map[string]struct {
+		pbMessage   proto.Message
+		unmarshaler net.TaggedUnmarshaler
+	}{
+		"ephemeral public key message - sender": {
+			pbMessage:   &pb.EphemeralPublicKeyMessage{SenderID: overflowingIndex},
+			unmarshaler: &ephemeralPublicKeyMessage{},
+		},
+		"tss round one message - sender": {
+			pbMessage:   &pb.TSSRoundOneMessage{SenderID: overflowingIndex},
+			unmarshaler: &tssRoundOneMessage{},
+		},
+		"tss round one message - receiver": {
+			pbMessage: &pb.TSSRoundOneMessage{
+				SenderID:     1,
+				PeersPayload: map[uint32][]byte{overflowingIndex: {1}},
+			},
+			unmarshaler: &tssRoundOneMessage{},
+		},
+		"tss round two message - sender": {
+			pbMessage:   &pb.TSSRoundTwoMessage{SenderID: overflowingIndex},
+			unmarshaler: &tssRoundTwoMessage{},
+		},
+		"tss round two message - receiver": {
+			pbMessage: &pb.TSSRoundTwoMessage{
+				SenderID:     1,
+				PeersPayload: map[uint32][]byte{overflowingIndex: {1}},
+			},
+			unmarshaler: &tssRoundTwoMessage{},
+		},
+		"tss round three message - sender": {
+			pbMessage:   &pb.TSSRoundThreeMessage{SenderID: overflowingIndex},
+			unmarshaler: &tssRoundThreeMessage{},
+		},
+		"tss round four message - sender": {
+			pbMessage:   &pb.TSSRoundFourMessage{SenderID: overflowingIndex},
+			unmarshaler: &tssRoundFourMessage{},
+		},
+		"tss round five message - sender": {
+			pbMessage:   &pb.TSSRoundFiveMessage{SenderID: overflowingIndex},
+			unmarshaler: &tssRoundFiveMessage{},
+		},
+		"tss round six message - sender": {
+			pbMessage:   &pb.TSSRoundSixMessage{SenderID: overflowingIndex},
+			unmarshaler: &tssRoundSixMessage{},
+		},
+		"tss round seven message - sender": {
+			pbMessage:   &pb.TSSRoundSevenMessage{SenderID: overflowingIndex},
+			unmarshaler: &tssRoundSevenMessage{},
+		},
+		"tss round eight message - sender": {
+			pbMessage:   &pb.TSSRoundEightMessage{SenderID: overflowingIndex},
+			unmarshaler: &tssRoundEightMessage{},
+		},
+		"tss round nine message - sender": {
+			pbMessage:   &pb.TSSRoundNineMessage{SenderID: overflowingIndex},
+			unmarshaler: &tssRoundNineMessage{},
+		},
+	}
+
+	for testName, test := range tests {
+		t.Run(testName, func(t *testing.T) {
+			bytes, err := proto.Marshal(test.pbMessage)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = test.unmarshaler.Unmarshal(bytes)
+			if err == nil {
+				t.Errorf("expected member index overflow error")
+			}
+		})
+	}
+}